@@ -9,6 +9,7 @@ package gen
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -26,6 +27,10 @@ type CreateResourceRequest struct {
 	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Type          *string                `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
 	Data          []byte                 `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
+	Metadata      []byte                 `protobuf:"bytes,4,opt,name=metadata" json:"metadata,omitempty"`
+	EncryptedName []byte                 `protobuf:"bytes,5,opt,name=encrypted_name,json=encryptedName" json:"encrypted_name,omitempty"`
+	ExpiresAt     *string                `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt" json:"expires_at,omitempty"`
+	RotateEvery   *int64                 `protobuf:"varint,7,opt,name=rotate_every,json=rotateEvery" json:"rotate_every,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +86,34 @@ func (x *CreateResourceRequest) GetData() []byte {
 	return nil
 }
 
+func (x *CreateResourceRequest) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateResourceRequest) GetEncryptedName() []byte {
+	if x != nil {
+		return x.EncryptedName
+	}
+	return nil
+}
+
+func (x *CreateResourceRequest) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *CreateResourceRequest) GetRotateEvery() int64 {
+	if x != nil && x.RotateEvery != nil {
+		return *x.RotateEvery
+	}
+	return 0
+}
+
 type CreateResourceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
@@ -88,6 +121,7 @@ type CreateResourceResponse struct {
 	Type          *string                `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
 	Size          *int64                 `protobuf:"varint,4,opt,name=size" json:"size,omitempty"`
 	CreatedAt     *string                `protobuf:"bytes,5,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	Revision      *int64                 `protobuf:"varint,6,opt,name=revision" json:"revision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -157,6 +191,13 @@ func (x *CreateResourceResponse) GetCreatedAt() string {
 	return ""
 }
 
+func (x *CreateResourceResponse) GetRevision() int64 {
+	if x != nil && x.Revision != nil {
+		return *x.Revision
+	}
+	return 0
+}
+
 type GetResourceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
@@ -204,6 +245,7 @@ func (x *GetResourceRequest) GetId() int64 {
 type GetResourceByNameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	WithoutData   *bool                  `protobuf:"varint,2,opt,name=without_data,json=withoutData" json:"without_data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -245,6 +287,13 @@ func (x *GetResourceByNameRequest) GetName() string {
 	return ""
 }
 
+func (x *GetResourceByNameRequest) GetWithoutData() bool {
+	if x != nil && x.WithoutData != nil {
+		return *x.WithoutData
+	}
+	return false
+}
+
 type GetResourceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
@@ -254,6 +303,14 @@ type GetResourceResponse struct {
 	Size          *int64                 `protobuf:"varint,5,opt,name=size" json:"size,omitempty"`
 	CreatedAt     *string                `protobuf:"bytes,6,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
 	UpdatedAt     *string                `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt" json:"updated_at,omitempty"`
+	DeletedAt     *string                `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt" json:"deleted_at,omitempty"`
+	Revision      *int64                 `protobuf:"varint,9,opt,name=revision" json:"revision,omitempty"`
+	Metadata      []byte                 `protobuf:"bytes,10,opt,name=metadata" json:"metadata,omitempty"`
+	EncryptedName []byte                 `protobuf:"bytes,11,opt,name=encrypted_name,json=encryptedName" json:"encrypted_name,omitempty"`
+	ExpiresAt     *string                `protobuf:"bytes,12,opt,name=expires_at,json=expiresAt" json:"expires_at,omitempty"`
+	RotateEvery   *int64                 `protobuf:"varint,13,opt,name=rotate_every,json=rotateEvery" json:"rotate_every,omitempty"`
+	RotatedAt     *string                `protobuf:"bytes,14,opt,name=rotated_at,json=rotatedAt" json:"rotated_at,omitempty"`
+	DueAt         *string                `protobuf:"bytes,15,opt,name=due_at,json=dueAt" json:"due_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -337,9 +394,75 @@ func (x *GetResourceResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *GetResourceResponse) GetDeletedAt() string {
+	if x != nil && x.DeletedAt != nil {
+		return *x.DeletedAt
+	}
+	return ""
+}
+
+func (x *GetResourceResponse) GetRevision() int64 {
+	if x != nil && x.Revision != nil {
+		return *x.Revision
+	}
+	return 0
+}
+
+func (x *GetResourceResponse) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetResourceResponse) GetEncryptedName() []byte {
+	if x != nil {
+		return x.EncryptedName
+	}
+	return nil
+}
+
+func (x *GetResourceResponse) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *GetResourceResponse) GetRotateEvery() int64 {
+	if x != nil && x.RotateEvery != nil {
+		return *x.RotateEvery
+	}
+	return 0
+}
+
+func (x *GetResourceResponse) GetRotatedAt() string {
+	if x != nil && x.RotatedAt != nil {
+		return *x.RotatedAt
+	}
+	return ""
+}
+
+func (x *GetResourceResponse) GetDueAt() string {
+	if x != nil && x.DueAt != nil {
+		return *x.DueAt
+	}
+	return ""
+}
+
 type ListResourcesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          *string                `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	PathPrefix    *string                `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix" json:"path_prefix,omitempty"`
+	NamePrefix    *string                `protobuf:"bytes,3,opt,name=name_prefix,json=namePrefix" json:"name_prefix,omitempty"`
+	CreatedAfter  *string                `protobuf:"bytes,4,opt,name=created_after,json=createdAfter" json:"created_after,omitempty"`
+	CreatedBefore *string                `protobuf:"bytes,5,opt,name=created_before,json=createdBefore" json:"created_before,omitempty"`
+	UpdatedAfter  *string                `protobuf:"bytes,6,opt,name=updated_after,json=updatedAfter" json:"updated_after,omitempty"`
+	UpdatedBefore *string                `protobuf:"bytes,7,opt,name=updated_before,json=updatedBefore" json:"updated_before,omitempty"`
+	SortBy        *string                `protobuf:"bytes,8,opt,name=sort_by,json=sortBy" json:"sort_by,omitempty"`
+	SortDesc      *bool                  `protobuf:"varint,9,opt,name=sort_desc,json=sortDesc" json:"sort_desc,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,10,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	PageToken     *string                `protobuf:"bytes,11,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -381,9 +504,80 @@ func (x *ListResourcesRequest) GetType() string {
 	return ""
 }
 
+func (x *ListResourcesRequest) GetPathPrefix() string {
+	if x != nil && x.PathPrefix != nil {
+		return *x.PathPrefix
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetNamePrefix() string {
+	if x != nil && x.NamePrefix != nil {
+		return *x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetCreatedAfter() string {
+	if x != nil && x.CreatedAfter != nil {
+		return *x.CreatedAfter
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetCreatedBefore() string {
+	if x != nil && x.CreatedBefore != nil {
+		return *x.CreatedBefore
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetUpdatedAfter() string {
+	if x != nil && x.UpdatedAfter != nil {
+		return *x.UpdatedAfter
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetUpdatedBefore() string {
+	if x != nil && x.UpdatedBefore != nil {
+		return *x.UpdatedBefore
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetSortBy() string {
+	if x != nil && x.SortBy != nil {
+		return *x.SortBy
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest) GetSortDesc() bool {
+	if x != nil && x.SortDesc != nil {
+		return *x.SortDesc
+	}
+	return false
+}
+
+func (x *ListResourcesRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListResourcesRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
 type ListResourcesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Resources     []*GetResourceResponse `protobuf:"bytes,1,rep,name=resources" json:"resources,omitempty"`
+	NextPageToken *string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -425,14 +619,27 @@ func (x *ListResourcesResponse) GetResources() []*GetResourceResponse {
 	return nil
 }
 
+func (x *ListResourcesResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
 type UpdateResourceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
-	Type          *string                `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
-	Data          []byte                 `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name             *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Type             *string                `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
+	Data             []byte                 `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
+	ExpectedRevision *int64                 `protobuf:"varint,5,opt,name=expected_revision,json=expectedRevision" json:"expected_revision,omitempty"`
+	Metadata         []byte                 `protobuf:"bytes,6,opt,name=metadata" json:"metadata,omitempty"`
+	EncryptedName    []byte                 `protobuf:"bytes,7,opt,name=encrypted_name,json=encryptedName" json:"encrypted_name,omitempty"`
+	UpdateMask       *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask" json:"update_mask,omitempty"`
+	ExpiresAt        *string                `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt" json:"expires_at,omitempty"`
+	RotateEvery      *int64                 `protobuf:"varint,10,opt,name=rotate_every,json=rotateEvery" json:"rotate_every,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *UpdateResourceRequest) Reset() {
@@ -493,11 +700,54 @@ func (x *UpdateResourceRequest) GetData() []byte {
 	return nil
 }
 
+func (x *UpdateResourceRequest) GetExpectedRevision() int64 {
+	if x != nil && x.ExpectedRevision != nil {
+		return *x.ExpectedRevision
+	}
+	return 0
+}
+
+func (x *UpdateResourceRequest) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UpdateResourceRequest) GetEncryptedName() []byte {
+	if x != nil {
+		return x.EncryptedName
+	}
+	return nil
+}
+
+func (x *UpdateResourceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateResourceRequest) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *UpdateResourceRequest) GetRotateEvery() int64 {
+	if x != nil && x.RotateEvery != nil {
+		return *x.RotateEvery
+	}
+	return 0
+}
+
 type UpdateResourceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 	Name          *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
 	UpdatedAt     *string                `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt" json:"updated_at,omitempty"`
+	Revision      *int64                 `protobuf:"varint,4,opt,name=revision" json:"revision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -553,27 +803,37 @@ func (x *UpdateResourceResponse) GetUpdatedAt() string {
 	return ""
 }
 
-type DeleteResourceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateResourceResponse) GetRevision() int64 {
+	if x != nil && x.Revision != nil {
+		return *x.Revision
+	}
+	return 0
 }
 
-func (x *DeleteResourceRequest) Reset() {
-	*x = DeleteResourceRequest{}
+type RenameResourceRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	NewName          *string                `protobuf:"bytes,2,opt,name=new_name,json=newName" json:"new_name,omitempty"`
+	ExpectedRevision *int64                 `protobuf:"varint,3,opt,name=expected_revision,json=expectedRevision" json:"expected_revision,omitempty"`
+	EncryptedName    []byte                 `protobuf:"bytes,4,opt,name=encrypted_name,json=encryptedName" json:"encrypted_name,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RenameResourceRequest) Reset() {
+	*x = RenameResourceRequest{}
 	mi := &file_resource_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResourceRequest) String() string {
+func (x *RenameResourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResourceRequest) ProtoMessage() {}
+func (*RenameResourceRequest) ProtoMessage() {}
 
-func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
+func (x *RenameResourceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -585,39 +845,62 @@ func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResourceRequest.ProtoReflect.Descriptor instead.
-func (*DeleteResourceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenameResourceRequest.ProtoReflect.Descriptor instead.
+func (*RenameResourceRequest) Descriptor() ([]byte, []int) {
 	return file_resource_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteResourceRequest) GetId() int64 {
+func (x *RenameResourceRequest) GetId() int64 {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return 0
 }
 
-type DeleteResourceResponse struct {
+func (x *RenameResourceRequest) GetNewName() string {
+	if x != nil && x.NewName != nil {
+		return *x.NewName
+	}
+	return ""
+}
+
+func (x *RenameResourceRequest) GetExpectedRevision() int64 {
+	if x != nil && x.ExpectedRevision != nil {
+		return *x.ExpectedRevision
+	}
+	return 0
+}
+
+func (x *RenameResourceRequest) GetEncryptedName() []byte {
+	if x != nil {
+		return x.EncryptedName
+	}
+	return nil
+}
+
+type RenameResourceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       *bool                  `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Revision      *int64                 `protobuf:"varint,3,opt,name=revision" json:"revision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResourceResponse) Reset() {
-	*x = DeleteResourceResponse{}
+func (x *RenameResourceResponse) Reset() {
+	*x = RenameResourceResponse{}
 	mi := &file_resource_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResourceResponse) String() string {
+func (x *RenameResourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResourceResponse) ProtoMessage() {}
+func (*RenameResourceResponse) ProtoMessage() {}
 
-func (x *DeleteResourceResponse) ProtoReflect() protoreflect.Message {
+func (x *RenameResourceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -629,73 +912,1616 @@ func (x *DeleteResourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResourceResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResourceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenameResourceResponse.ProtoReflect.Descriptor instead.
+func (*RenameResourceResponse) Descriptor() ([]byte, []int) {
 	return file_resource_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteResourceResponse) GetSuccess() bool {
-	if x != nil && x.Success != nil {
-		return *x.Success
+func (x *RenameResourceResponse) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
-	return false
+	return 0
 }
 
-var File_resource_proto protoreflect.FileDescriptor
+func (x *RenameResourceResponse) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
 
-const file_resource_proto_rawDesc = "" +
-	"\n" +
-	"\x0eresource.proto\x12\x13gophkeeper.resource\"S\n" +
-	"\x15CreateResourceRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
-	"\x04data\x18\x03 \x01(\fR\x04data\"\x83\x01\n" +
-	"\x16CreateResourceResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
-	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\"$\n" +
-	"\x12GetResourceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\".\n" +
-	"\x18GetResourceByNameRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"\xb3\x01\n" +
-	"\x13GetResourceResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
-	"\x04data\x18\x04 \x01(\fR\x04data\x12\x12\n" +
-	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\a \x01(\tR\tupdatedAt\"*\n" +
-	"\x14ListResourcesRequest\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\"_\n" +
-	"\x15ListResourcesResponse\x12F\n" +
-	"\tresources\x18\x01 \x03(\v2(.gophkeeper.resource.GetResourceResponseR\tresources\"c\n" +
-	"\x15UpdateResourceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
-	"\x04data\x18\x04 \x01(\fR\x04data\"[\n" +
-	"\x16UpdateResourceResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x03 \x01(\tR\tupdatedAt\"'\n" +
-	"\x15DeleteResourceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
-	"\x16DeleteResourceResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x8a\x05\n" +
-	"\x0fResourceService\x12i\n" +
-	"\x0eCreateResource\x12*.gophkeeper.resource.CreateResourceRequest\x1a+.gophkeeper.resource.CreateResourceResponse\x12`\n" +
-	"\vGetResource\x12'.gophkeeper.resource.GetResourceRequest\x1a(.gophkeeper.resource.GetResourceResponse\x12l\n" +
-	"\x11GetResourceByName\x12-.gophkeeper.resource.GetResourceByNameRequest\x1a(.gophkeeper.resource.GetResourceResponse\x12f\n" +
-	"\rListResources\x12).gophkeeper.resource.ListResourcesRequest\x1a*.gophkeeper.resource.ListResourcesResponse\x12i\n" +
-	"\x0eUpdateResource\x12*.gophkeeper.resource.UpdateResourceRequest\x1a+.gophkeeper.resource.UpdateResourceResponse\x12i\n" +
-	"\x0eDeleteResource\x12*.gophkeeper.resource.DeleteResourceRequest\x1a+.gophkeeper.resource.DeleteResourceResponseB4Z2github.com/OvsienkoValeriya/GophKeeper/api/gen;genb\beditionsp\xe8\a"
+func (x *RenameResourceResponse) GetRevision() int64 {
+	if x != nil && x.Revision != nil {
+		return *x.Revision
+	}
+	return 0
+}
+
+type MoveFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *string                `protobuf:"bytes,1,opt,name=from" json:"from,omitempty"`
+	To            *string                `protobuf:"bytes,2,opt,name=to" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveFolderRequest) Reset() {
+	*x = MoveFolderRequest{}
+	mi := &file_resource_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFolderRequest) ProtoMessage() {}
+
+func (x *MoveFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFolderRequest.ProtoReflect.Descriptor instead.
+func (*MoveFolderRequest) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MoveFolderRequest) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *MoveFolderRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+type MoveFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moved         *int64                 `protobuf:"varint,1,opt,name=moved" json:"moved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveFolderResponse) Reset() {
+	*x = MoveFolderResponse{}
+	mi := &file_resource_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFolderResponse) ProtoMessage() {}
+
+func (x *MoveFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFolderResponse.ProtoReflect.Descriptor instead.
+func (*MoveFolderResponse) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MoveFolderResponse) GetMoved() int64 {
+	if x != nil && x.Moved != nil {
+		return *x.Moved
+	}
+	return 0
+}
+
+type DeleteResourceRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	ExpectedRevision *int64                 `protobuf:"varint,2,opt,name=expected_revision,json=expectedRevision" json:"expected_revision,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteResourceRequest) Reset() {
+	*x = DeleteResourceRequest{}
+	mi := &file_resource_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceRequest) ProtoMessage() {}
+
+func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteResourceRequest) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *DeleteResourceRequest) GetExpectedRevision() int64 {
+	if x != nil && x.ExpectedRevision != nil {
+		return *x.ExpectedRevision
+	}
+	return 0
+}
+
+type DeleteResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       *bool                  `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourceResponse) Reset() {
+	*x = DeleteResourceResponse{}
+	mi := &file_resource_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceResponse) ProtoMessage() {}
+
+func (x *DeleteResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResourceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteResourceResponse) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_resource_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{15}
+}
+
+type ListTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resources     []*GetResourceResponse `protobuf:"bytes,1,rep,name=resources" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_resource_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListTrashResponse) GetResources() []*GetResourceResponse {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type RestoreResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreResourceRequest) Reset() {
+	*x = RestoreResourceRequest{}
+	mi := &file_resource_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResourceRequest) ProtoMessage() {}
+
+func (x *RestoreResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResourceRequest.ProtoReflect.Descriptor instead.
+func (*RestoreResourceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestoreResourceRequest) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type RestoreResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Revision      *int64                 `protobuf:"varint,3,opt,name=revision" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreResourceResponse) Reset() {
+	*x = RestoreResourceResponse{}
+	mi := &file_resource_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResourceResponse) ProtoMessage() {}
+
+func (x *RestoreResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResourceResponse.ProtoReflect.Descriptor instead.
+func (*RestoreResourceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RestoreResourceResponse) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *RestoreResourceResponse) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *RestoreResourceResponse) GetRevision() int64 {
+	if x != nil && x.Revision != nil {
+		return *x.Revision
+	}
+	return 0
+}
+
+type PurgeResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeResourceRequest) Reset() {
+	*x = PurgeResourceRequest{}
+	mi := &file_resource_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeResourceRequest) ProtoMessage() {}
+
+func (x *PurgeResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeResourceRequest.ProtoReflect.Descriptor instead.
+func (*PurgeResourceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PurgeResourceRequest) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type PurgeResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       *bool                  `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeResourceResponse) Reset() {
+	*x = PurgeResourceResponse{}
+	mi := &file_resource_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeResourceResponse) ProtoMessage() {}
+
+func (x *PurgeResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeResourceResponse.ProtoReflect.Descriptor instead.
+func (*PurgeResourceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PurgeResourceResponse) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	ResourceId    *int64                 `protobuf:"varint,2,opt,name=resource_id,json=resourceId" json:"resource_id,omitempty"`
+	EncryptedName []byte                 `protobuf:"bytes,3,opt,name=encrypted_name,json=encryptedName" json:"encrypted_name,omitempty"`
+	Metadata      []byte                 `protobuf:"bytes,4,opt,name=metadata" json:"metadata,omitempty"`
+	Data          []byte                 `protobuf:"bytes,5,opt,name=data" json:"data,omitempty"`
+	Size          *int64                 `protobuf:"varint,6,opt,name=size" json:"size,omitempty"`
+	CreatedAt     *string                `protobuf:"bytes,7,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_resource_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Attachment) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Attachment) GetResourceId() int64 {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return 0
+}
+
+func (x *Attachment) GetEncryptedName() []byte {
+	if x != nil {
+		return x.EncryptedName
+	}
+	return nil
+}
+
+func (x *Attachment) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Attachment) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetCreatedAt() string {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return ""
+}
+
+type AddAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    *int64                 `protobuf:"varint,1,opt,name=resource_id,json=resourceId" json:"resource_id,omitempty"`
+	EncryptedName []byte                 `protobuf:"bytes,2,opt,name=encrypted_name,json=encryptedName" json:"encrypted_name,omitempty"`
+	Metadata      []byte                 `protobuf:"bytes,3,opt,name=metadata" json:"metadata,omitempty"`
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAttachmentRequest) Reset() {
+	*x = AddAttachmentRequest{}
+	mi := &file_resource_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAttachmentRequest) ProtoMessage() {}
+
+func (x *AddAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*AddAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddAttachmentRequest) GetResourceId() int64 {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return 0
+}
+
+func (x *AddAttachmentRequest) GetEncryptedName() []byte {
+	if x != nil {
+		return x.EncryptedName
+	}
+	return nil
+}
+
+func (x *AddAttachmentRequest) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AddAttachmentRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AddAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAttachmentResponse) Reset() {
+	*x = AddAttachmentResponse{}
+	mi := &file_resource_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAttachmentResponse) ProtoMessage() {}
+
+func (x *AddAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*AddAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddAttachmentResponse) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    *int64                 `protobuf:"varint,1,opt,name=resource_id,json=resourceId" json:"resource_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_resource_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListAttachmentsRequest) GetResourceId() int64 {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return 0
+}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_resource_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type GetAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentRequest) Reset() {
+	*x = GetAttachmentRequest{}
+	mi := &file_resource_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentRequest) ProtoMessage() {}
+
+func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetAttachmentRequest) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *int64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_resource_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteAttachmentRequest) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       *bool                  `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_resource_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteAttachmentResponse) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+type FieldDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Label         *string                `protobuf:"bytes,2,opt,name=label" json:"label,omitempty"`
+	Kind          *string                `protobuf:"bytes,3,opt,name=kind" json:"kind,omitempty"`
+	Secret        *bool                  `protobuf:"varint,4,opt,name=secret" json:"secret,omitempty"`
+	Required      *bool                  `protobuf:"varint,5,opt,name=required" json:"required,omitempty"`
+	Description   *string                `protobuf:"bytes,6,opt,name=description" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldDefinition) Reset() {
+	*x = FieldDefinition{}
+	mi := &file_resource_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDefinition) ProtoMessage() {}
+
+func (x *FieldDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDefinition.ProtoReflect.Descriptor instead.
+func (*FieldDefinition) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FieldDefinition) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *FieldDefinition) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *FieldDefinition) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *FieldDefinition) GetSecret() bool {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return false
+}
+
+func (x *FieldDefinition) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *FieldDefinition) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type ResourceTypeDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Fields        []*FieldDefinition     `protobuf:"bytes,3,rep,name=fields" json:"fields,omitempty"`
+	BuiltIn       *bool                  `protobuf:"varint,4,opt,name=built_in,json=builtIn" json:"built_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceTypeDefinition) Reset() {
+	*x = ResourceTypeDefinition{}
+	mi := &file_resource_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceTypeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceTypeDefinition) ProtoMessage() {}
+
+func (x *ResourceTypeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceTypeDefinition.ProtoReflect.Descriptor instead.
+func (*ResourceTypeDefinition) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ResourceTypeDefinition) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ResourceTypeDefinition) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ResourceTypeDefinition) GetFields() []*FieldDefinition {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ResourceTypeDefinition) GetBuiltIn() bool {
+	if x != nil && x.BuiltIn != nil {
+		return *x.BuiltIn
+	}
+	return false
+}
+
+type ListResourceTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourceTypesRequest) Reset() {
+	*x = ListResourceTypesRequest{}
+	mi := &file_resource_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourceTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourceTypesRequest) ProtoMessage() {}
+
+func (x *ListResourceTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourceTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListResourceTypesRequest) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{31}
+}
+
+type ListResourceTypesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Types         []*ResourceTypeDefinition `protobuf:"bytes,1,rep,name=types" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourceTypesResponse) Reset() {
+	*x = ListResourceTypesResponse{}
+	mi := &file_resource_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourceTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourceTypesResponse) ProtoMessage() {}
+
+func (x *ListResourceTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourceTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListResourceTypesResponse) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListResourceTypesResponse) GetTypes() []*ResourceTypeDefinition {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type DefineResourceTypeRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Definition    *ResourceTypeDefinition `protobuf:"bytes,1,opt,name=definition" json:"definition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefineResourceTypeRequest) Reset() {
+	*x = DefineResourceTypeRequest{}
+	mi := &file_resource_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefineResourceTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefineResourceTypeRequest) ProtoMessage() {}
+
+func (x *DefineResourceTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefineResourceTypeRequest.ProtoReflect.Descriptor instead.
+func (*DefineResourceTypeRequest) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DefineResourceTypeRequest) GetDefinition() *ResourceTypeDefinition {
+	if x != nil {
+		return x.Definition
+	}
+	return nil
+}
+
+type DefineResourceTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       *bool                  `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefineResourceTypeResponse) Reset() {
+	*x = DefineResourceTypeResponse{}
+	mi := &file_resource_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefineResourceTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefineResourceTypeResponse) ProtoMessage() {}
+
+func (x *DefineResourceTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefineResourceTypeResponse.ProtoReflect.Descriptor instead.
+func (*DefineResourceTypeResponse) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DefineResourceTypeResponse) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+type DeleteResourceTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourceTypeRequest) Reset() {
+	*x = DeleteResourceTypeRequest{}
+	mi := &file_resource_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceTypeRequest) ProtoMessage() {}
+
+func (x *DeleteResourceTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourceTypeRequest) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteResourceTypeRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type DeleteResourceTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       *bool                  `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourceTypeResponse) Reset() {
+	*x = DeleteResourceTypeResponse{}
+	mi := &file_resource_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceTypeResponse) ProtoMessage() {}
+
+func (x *DeleteResourceTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResourceTypeResponse) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteResourceTypeResponse) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+type ListExpiringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Before        *string                `protobuf:"bytes,1,opt,name=before" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringRequest) Reset() {
+	*x = ListExpiringRequest{}
+	mi := &file_resource_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringRequest) ProtoMessage() {}
+
+func (x *ListExpiringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringRequest.ProtoReflect.Descriptor instead.
+func (*ListExpiringRequest) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListExpiringRequest) GetBefore() string {
+	if x != nil && x.Before != nil {
+		return *x.Before
+	}
+	return ""
+}
+
+type ListExpiringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resources     []*GetResourceResponse `protobuf:"bytes,1,rep,name=resources" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringResponse) Reset() {
+	*x = ListExpiringResponse{}
+	mi := &file_resource_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringResponse) ProtoMessage() {}
+
+func (x *ListExpiringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringResponse.ProtoReflect.Descriptor instead.
+func (*ListExpiringResponse) Descriptor() ([]byte, []int) {
+	return file_resource_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListExpiringResponse) GetResources() []*GetResourceResponse {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+var File_resource_proto protoreflect.FileDescriptor
+
+const file_resource_proto_rawDesc = "" +
+	"\n" +
+	"\x0eresource.proto\x12\x13gophkeeper.resource\x1a google/protobuf/field_mask.proto\"\xd8\x01\n" +
+	"\x15CreateResourceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x1a\n" +
+	"\bmetadata\x18\x04 \x01(\fR\bmetadata\x12%\n" +
+	"\x0eencrypted_name\x18\x05 \x01(\fR\rencryptedName\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\x12!\n" +
+	"\frotate_every\x18\a \x01(\x03R\vrotateEvery\"\x9f\x01\n" +
+	"\x16CreateResourceResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1a\n" +
+	"\brevision\x18\x06 \x01(\x03R\brevision\"$\n" +
+	"\x12GetResourceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"Q\n" +
+	"\x18GetResourceByNameRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fwithout_data\x18\x02 \x01(\bR\vwithoutData\"\xa9\x03\n" +
+	"\x13GetResourceResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\tR\tdeletedAt\x12\x1a\n" +
+	"\brevision\x18\t \x01(\x03R\brevision\x12\x1a\n" +
+	"\bmetadata\x18\n" +
+	" \x01(\fR\bmetadata\x12%\n" +
+	"\x0eencrypted_name\x18\v \x01(\fR\rencryptedName\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\f \x01(\tR\texpiresAt\x12!\n" +
+	"\frotate_every\x18\r \x01(\x03R\vrotateEvery\x12\x1d\n" +
+	"\n" +
+	"rotated_at\x18\x0e \x01(\tR\trotatedAt\x12\x15\n" +
+	"\x06due_at\x18\x0f \x01(\tR\x05dueAt\"\xf6\x02\n" +
+	"\x14ListResourcesRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1f\n" +
+	"\vpath_prefix\x18\x02 \x01(\tR\n" +
+	"pathPrefix\x12\x1f\n" +
+	"\vname_prefix\x18\x03 \x01(\tR\n" +
+	"namePrefix\x12#\n" +
+	"\rcreated_after\x18\x04 \x01(\tR\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\x05 \x01(\tR\rcreatedBefore\x12#\n" +
+	"\rupdated_after\x18\x06 \x01(\tR\fupdatedAfter\x12%\n" +
+	"\x0eupdated_before\x18\a \x01(\tR\rupdatedBefore\x12\x17\n" +
+	"\asort_by\x18\b \x01(\tR\x06sortBy\x12\x1b\n" +
+	"\tsort_desc\x18\t \x01(\bR\bsortDesc\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\"\x87\x01\n" +
+	"\x15ListResourcesResponse\x12F\n" +
+	"\tresources\x18\x01 \x03(\v2(.gophkeeper.resource.GetResourceResponseR\tresources\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd2\x02\n" +
+	"\x15UpdateResourceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\x12+\n" +
+	"\x11expected_revision\x18\x05 \x01(\x03R\x10expectedRevision\x12\x1a\n" +
+	"\bmetadata\x18\x06 \x01(\fR\bmetadata\x12%\n" +
+	"\x0eencrypted_name\x18\a \x01(\fR\rencryptedName\x12;\n" +
+	"\vupdate_mask\x18\b \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\tR\texpiresAt\x12!\n" +
+	"\frotate_every\x18\n" +
+	" \x01(\x03R\vrotateEvery\"w\n" +
+	"\x16UpdateResourceResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1a\n" +
+	"\brevision\x18\x04 \x01(\x03R\brevision\"\x96\x01\n" +
+	"\x15RenameResourceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bnew_name\x18\x02 \x01(\tR\anewName\x12+\n" +
+	"\x11expected_revision\x18\x03 \x01(\x03R\x10expectedRevision\x12%\n" +
+	"\x0eencrypted_name\x18\x04 \x01(\fR\rencryptedName\"X\n" +
+	"\x16RenameResourceResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x03R\brevision\"7\n" +
+	"\x11MoveFolderRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"*\n" +
+	"\x12MoveFolderResponse\x12\x14\n" +
+	"\x05moved\x18\x01 \x01(\x03R\x05moved\"T\n" +
+	"\x15DeleteResourceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12+\n" +
+	"\x11expected_revision\x18\x02 \x01(\x03R\x10expectedRevision\"2\n" +
+	"\x16DeleteResourceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x12\n" +
+	"\x10ListTrashRequest\"[\n" +
+	"\x11ListTrashResponse\x12F\n" +
+	"\tresources\x18\x01 \x03(\v2(.gophkeeper.resource.GetResourceResponseR\tresources\"(\n" +
+	"\x16RestoreResourceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"Y\n" +
+	"\x17RestoreResourceResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x03R\brevision\"&\n" +
+	"\x14PurgeResourceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"1\n" +
+	"\x15PurgeResourceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc7\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\x03R\n" +
+	"resourceId\x12%\n" +
+	"\x0eencrypted_name\x18\x03 \x01(\fR\rencryptedName\x12\x1a\n" +
+	"\bmetadata\x18\x04 \x01(\fR\bmetadata\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\x8e\x01\n" +
+	"\x14AddAttachmentRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12%\n" +
+	"\x0eencrypted_name\x18\x02 \x01(\fR\rencryptedName\x12\x1a\n" +
+	"\bmetadata\x18\x03 \x01(\fR\bmetadata\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"'\n" +
+	"\x15AddAttachmentResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"9\n" +
+	"\x16ListAttachmentsRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\"\\\n" +
+	"\x17ListAttachmentsResponse\x12A\n" +
+	"\vattachments\x18\x01 \x03(\v2\x1f.gophkeeper.resource.AttachmentR\vattachments\"&\n" +
+	"\x14GetAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\")\n" +
+	"\x17DeleteAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"4\n" +
+	"\x18DeleteAttachmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa5\x01\n" +
+	"\x0fFieldDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\bR\x06secret\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"\xa7\x01\n" +
+	"\x16ResourceTypeDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12<\n" +
+	"\x06fields\x18\x03 \x03(\v2$.gophkeeper.resource.FieldDefinitionR\x06fields\x12\x19\n" +
+	"\bbuilt_in\x18\x04 \x01(\bR\abuiltIn\"\x1a\n" +
+	"\x18ListResourceTypesRequest\"^\n" +
+	"\x19ListResourceTypesResponse\x12A\n" +
+	"\x05types\x18\x01 \x03(\v2+.gophkeeper.resource.ResourceTypeDefinitionR\x05types\"h\n" +
+	"\x19DefineResourceTypeRequest\x12K\n" +
+	"\n" +
+	"definition\x18\x01 \x01(\v2+.gophkeeper.resource.ResourceTypeDefinitionR\n" +
+	"definition\"6\n" +
+	"\x1aDefineResourceTypeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
+	"\x19DeleteResourceTypeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"6\n" +
+	"\x1aDeleteResourceTypeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"-\n" +
+	"\x13ListExpiringRequest\x12\x16\n" +
+	"\x06before\x18\x01 \x01(\tR\x06before\"^\n" +
+	"\x14ListExpiringResponse\x12F\n" +
+	"\tresources\x18\x01 \x03(\v2(.gophkeeper.resource.GetResourceResponseR\tresources2\xf1\x0f\n" +
+	"\x0fResourceService\x12i\n" +
+	"\x0eCreateResource\x12*.gophkeeper.resource.CreateResourceRequest\x1a+.gophkeeper.resource.CreateResourceResponse\x12`\n" +
+	"\vGetResource\x12'.gophkeeper.resource.GetResourceRequest\x1a(.gophkeeper.resource.GetResourceResponse\x12l\n" +
+	"\x11GetResourceByName\x12-.gophkeeper.resource.GetResourceByNameRequest\x1a(.gophkeeper.resource.GetResourceResponse\x12f\n" +
+	"\rListResources\x12).gophkeeper.resource.ListResourcesRequest\x1a*.gophkeeper.resource.ListResourcesResponse\x12i\n" +
+	"\x0eUpdateResource\x12*.gophkeeper.resource.UpdateResourceRequest\x1a+.gophkeeper.resource.UpdateResourceResponse\x12i\n" +
+	"\x0eRenameResource\x12*.gophkeeper.resource.RenameResourceRequest\x1a+.gophkeeper.resource.RenameResourceResponse\x12]\n" +
+	"\n" +
+	"MoveFolder\x12&.gophkeeper.resource.MoveFolderRequest\x1a'.gophkeeper.resource.MoveFolderResponse\x12i\n" +
+	"\x0eDeleteResource\x12*.gophkeeper.resource.DeleteResourceRequest\x1a+.gophkeeper.resource.DeleteResourceResponse\x12Z\n" +
+	"\tListTrash\x12%.gophkeeper.resource.ListTrashRequest\x1a&.gophkeeper.resource.ListTrashResponse\x12l\n" +
+	"\x0fRestoreResource\x12+.gophkeeper.resource.RestoreResourceRequest\x1a,.gophkeeper.resource.RestoreResourceResponse\x12f\n" +
+	"\rPurgeResource\x12).gophkeeper.resource.PurgeResourceRequest\x1a*.gophkeeper.resource.PurgeResourceResponse\x12f\n" +
+	"\rAddAttachment\x12).gophkeeper.resource.AddAttachmentRequest\x1a*.gophkeeper.resource.AddAttachmentResponse\x12l\n" +
+	"\x0fListAttachments\x12+.gophkeeper.resource.ListAttachmentsRequest\x1a,.gophkeeper.resource.ListAttachmentsResponse\x12[\n" +
+	"\rGetAttachment\x12).gophkeeper.resource.GetAttachmentRequest\x1a\x1f.gophkeeper.resource.Attachment\x12o\n" +
+	"\x10DeleteAttachment\x12,.gophkeeper.resource.DeleteAttachmentRequest\x1a-.gophkeeper.resource.DeleteAttachmentResponse\x12r\n" +
+	"\x11ListResourceTypes\x12-.gophkeeper.resource.ListResourceTypesRequest\x1a..gophkeeper.resource.ListResourceTypesResponse\x12u\n" +
+	"\x12DefineResourceType\x12..gophkeeper.resource.DefineResourceTypeRequest\x1a/.gophkeeper.resource.DefineResourceTypeResponse\x12u\n" +
+	"\x12DeleteResourceType\x12..gophkeeper.resource.DeleteResourceTypeRequest\x1a/.gophkeeper.resource.DeleteResourceTypeResponse\x12c\n" +
+	"\fListExpiring\x12(.gophkeeper.resource.ListExpiringRequest\x1a).gophkeeper.resource.ListExpiringResponseB4Z2github.com/OvsienkoValeriya/GophKeeper/api/gen;genb\beditionsp\xe8\a"
 
 var (
 	file_resource_proto_rawDescOnce sync.Once
@@ -709,39 +2535,101 @@ func file_resource_proto_rawDescGZIP() []byte {
 	return file_resource_proto_rawDescData
 }
 
-var file_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_resource_proto_goTypes = []any{
-	(*CreateResourceRequest)(nil),    // 0: gophkeeper.resource.CreateResourceRequest
-	(*CreateResourceResponse)(nil),   // 1: gophkeeper.resource.CreateResourceResponse
-	(*GetResourceRequest)(nil),       // 2: gophkeeper.resource.GetResourceRequest
-	(*GetResourceByNameRequest)(nil), // 3: gophkeeper.resource.GetResourceByNameRequest
-	(*GetResourceResponse)(nil),      // 4: gophkeeper.resource.GetResourceResponse
-	(*ListResourcesRequest)(nil),     // 5: gophkeeper.resource.ListResourcesRequest
-	(*ListResourcesResponse)(nil),    // 6: gophkeeper.resource.ListResourcesResponse
-	(*UpdateResourceRequest)(nil),    // 7: gophkeeper.resource.UpdateResourceRequest
-	(*UpdateResourceResponse)(nil),   // 8: gophkeeper.resource.UpdateResourceResponse
-	(*DeleteResourceRequest)(nil),    // 9: gophkeeper.resource.DeleteResourceRequest
-	(*DeleteResourceResponse)(nil),   // 10: gophkeeper.resource.DeleteResourceResponse
+	(*CreateResourceRequest)(nil),      // 0: gophkeeper.resource.CreateResourceRequest
+	(*CreateResourceResponse)(nil),     // 1: gophkeeper.resource.CreateResourceResponse
+	(*GetResourceRequest)(nil),         // 2: gophkeeper.resource.GetResourceRequest
+	(*GetResourceByNameRequest)(nil),   // 3: gophkeeper.resource.GetResourceByNameRequest
+	(*GetResourceResponse)(nil),        // 4: gophkeeper.resource.GetResourceResponse
+	(*ListResourcesRequest)(nil),       // 5: gophkeeper.resource.ListResourcesRequest
+	(*ListResourcesResponse)(nil),      // 6: gophkeeper.resource.ListResourcesResponse
+	(*UpdateResourceRequest)(nil),      // 7: gophkeeper.resource.UpdateResourceRequest
+	(*UpdateResourceResponse)(nil),     // 8: gophkeeper.resource.UpdateResourceResponse
+	(*RenameResourceRequest)(nil),      // 9: gophkeeper.resource.RenameResourceRequest
+	(*RenameResourceResponse)(nil),     // 10: gophkeeper.resource.RenameResourceResponse
+	(*MoveFolderRequest)(nil),          // 11: gophkeeper.resource.MoveFolderRequest
+	(*MoveFolderResponse)(nil),         // 12: gophkeeper.resource.MoveFolderResponse
+	(*DeleteResourceRequest)(nil),      // 13: gophkeeper.resource.DeleteResourceRequest
+	(*DeleteResourceResponse)(nil),     // 14: gophkeeper.resource.DeleteResourceResponse
+	(*ListTrashRequest)(nil),           // 15: gophkeeper.resource.ListTrashRequest
+	(*ListTrashResponse)(nil),          // 16: gophkeeper.resource.ListTrashResponse
+	(*RestoreResourceRequest)(nil),     // 17: gophkeeper.resource.RestoreResourceRequest
+	(*RestoreResourceResponse)(nil),    // 18: gophkeeper.resource.RestoreResourceResponse
+	(*PurgeResourceRequest)(nil),       // 19: gophkeeper.resource.PurgeResourceRequest
+	(*PurgeResourceResponse)(nil),      // 20: gophkeeper.resource.PurgeResourceResponse
+	(*Attachment)(nil),                 // 21: gophkeeper.resource.Attachment
+	(*AddAttachmentRequest)(nil),       // 22: gophkeeper.resource.AddAttachmentRequest
+	(*AddAttachmentResponse)(nil),      // 23: gophkeeper.resource.AddAttachmentResponse
+	(*ListAttachmentsRequest)(nil),     // 24: gophkeeper.resource.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),    // 25: gophkeeper.resource.ListAttachmentsResponse
+	(*GetAttachmentRequest)(nil),       // 26: gophkeeper.resource.GetAttachmentRequest
+	(*DeleteAttachmentRequest)(nil),    // 27: gophkeeper.resource.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),   // 28: gophkeeper.resource.DeleteAttachmentResponse
+	(*FieldDefinition)(nil),            // 29: gophkeeper.resource.FieldDefinition
+	(*ResourceTypeDefinition)(nil),     // 30: gophkeeper.resource.ResourceTypeDefinition
+	(*ListResourceTypesRequest)(nil),   // 31: gophkeeper.resource.ListResourceTypesRequest
+	(*ListResourceTypesResponse)(nil),  // 32: gophkeeper.resource.ListResourceTypesResponse
+	(*DefineResourceTypeRequest)(nil),  // 33: gophkeeper.resource.DefineResourceTypeRequest
+	(*DefineResourceTypeResponse)(nil), // 34: gophkeeper.resource.DefineResourceTypeResponse
+	(*DeleteResourceTypeRequest)(nil),  // 35: gophkeeper.resource.DeleteResourceTypeRequest
+	(*DeleteResourceTypeResponse)(nil), // 36: gophkeeper.resource.DeleteResourceTypeResponse
+	(*ListExpiringRequest)(nil),        // 37: gophkeeper.resource.ListExpiringRequest
+	(*ListExpiringResponse)(nil),       // 38: gophkeeper.resource.ListExpiringResponse
+	(*fieldmaskpb.FieldMask)(nil),      // 39: google.protobuf.FieldMask
 }
 var file_resource_proto_depIdxs = []int32{
 	4,  // 0: gophkeeper.resource.ListResourcesResponse.resources:type_name -> gophkeeper.resource.GetResourceResponse
-	0,  // 1: gophkeeper.resource.ResourceService.CreateResource:input_type -> gophkeeper.resource.CreateResourceRequest
-	2,  // 2: gophkeeper.resource.ResourceService.GetResource:input_type -> gophkeeper.resource.GetResourceRequest
-	3,  // 3: gophkeeper.resource.ResourceService.GetResourceByName:input_type -> gophkeeper.resource.GetResourceByNameRequest
-	5,  // 4: gophkeeper.resource.ResourceService.ListResources:input_type -> gophkeeper.resource.ListResourcesRequest
-	7,  // 5: gophkeeper.resource.ResourceService.UpdateResource:input_type -> gophkeeper.resource.UpdateResourceRequest
-	9,  // 6: gophkeeper.resource.ResourceService.DeleteResource:input_type -> gophkeeper.resource.DeleteResourceRequest
-	1,  // 7: gophkeeper.resource.ResourceService.CreateResource:output_type -> gophkeeper.resource.CreateResourceResponse
-	4,  // 8: gophkeeper.resource.ResourceService.GetResource:output_type -> gophkeeper.resource.GetResourceResponse
-	4,  // 9: gophkeeper.resource.ResourceService.GetResourceByName:output_type -> gophkeeper.resource.GetResourceResponse
-	6,  // 10: gophkeeper.resource.ResourceService.ListResources:output_type -> gophkeeper.resource.ListResourcesResponse
-	8,  // 11: gophkeeper.resource.ResourceService.UpdateResource:output_type -> gophkeeper.resource.UpdateResourceResponse
-	10, // 12: gophkeeper.resource.ResourceService.DeleteResource:output_type -> gophkeeper.resource.DeleteResourceResponse
-	7,  // [7:13] is the sub-list for method output_type
-	1,  // [1:7] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	39, // 1: gophkeeper.resource.UpdateResourceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,  // 2: gophkeeper.resource.ListTrashResponse.resources:type_name -> gophkeeper.resource.GetResourceResponse
+	21, // 3: gophkeeper.resource.ListAttachmentsResponse.attachments:type_name -> gophkeeper.resource.Attachment
+	29, // 4: gophkeeper.resource.ResourceTypeDefinition.fields:type_name -> gophkeeper.resource.FieldDefinition
+	30, // 5: gophkeeper.resource.ListResourceTypesResponse.types:type_name -> gophkeeper.resource.ResourceTypeDefinition
+	30, // 6: gophkeeper.resource.DefineResourceTypeRequest.definition:type_name -> gophkeeper.resource.ResourceTypeDefinition
+	4,  // 7: gophkeeper.resource.ListExpiringResponse.resources:type_name -> gophkeeper.resource.GetResourceResponse
+	0,  // 8: gophkeeper.resource.ResourceService.CreateResource:input_type -> gophkeeper.resource.CreateResourceRequest
+	2,  // 9: gophkeeper.resource.ResourceService.GetResource:input_type -> gophkeeper.resource.GetResourceRequest
+	3,  // 10: gophkeeper.resource.ResourceService.GetResourceByName:input_type -> gophkeeper.resource.GetResourceByNameRequest
+	5,  // 11: gophkeeper.resource.ResourceService.ListResources:input_type -> gophkeeper.resource.ListResourcesRequest
+	7,  // 12: gophkeeper.resource.ResourceService.UpdateResource:input_type -> gophkeeper.resource.UpdateResourceRequest
+	9,  // 13: gophkeeper.resource.ResourceService.RenameResource:input_type -> gophkeeper.resource.RenameResourceRequest
+	11, // 14: gophkeeper.resource.ResourceService.MoveFolder:input_type -> gophkeeper.resource.MoveFolderRequest
+	13, // 15: gophkeeper.resource.ResourceService.DeleteResource:input_type -> gophkeeper.resource.DeleteResourceRequest
+	15, // 16: gophkeeper.resource.ResourceService.ListTrash:input_type -> gophkeeper.resource.ListTrashRequest
+	17, // 17: gophkeeper.resource.ResourceService.RestoreResource:input_type -> gophkeeper.resource.RestoreResourceRequest
+	19, // 18: gophkeeper.resource.ResourceService.PurgeResource:input_type -> gophkeeper.resource.PurgeResourceRequest
+	22, // 19: gophkeeper.resource.ResourceService.AddAttachment:input_type -> gophkeeper.resource.AddAttachmentRequest
+	24, // 20: gophkeeper.resource.ResourceService.ListAttachments:input_type -> gophkeeper.resource.ListAttachmentsRequest
+	26, // 21: gophkeeper.resource.ResourceService.GetAttachment:input_type -> gophkeeper.resource.GetAttachmentRequest
+	27, // 22: gophkeeper.resource.ResourceService.DeleteAttachment:input_type -> gophkeeper.resource.DeleteAttachmentRequest
+	31, // 23: gophkeeper.resource.ResourceService.ListResourceTypes:input_type -> gophkeeper.resource.ListResourceTypesRequest
+	33, // 24: gophkeeper.resource.ResourceService.DefineResourceType:input_type -> gophkeeper.resource.DefineResourceTypeRequest
+	35, // 25: gophkeeper.resource.ResourceService.DeleteResourceType:input_type -> gophkeeper.resource.DeleteResourceTypeRequest
+	37, // 26: gophkeeper.resource.ResourceService.ListExpiring:input_type -> gophkeeper.resource.ListExpiringRequest
+	1,  // 27: gophkeeper.resource.ResourceService.CreateResource:output_type -> gophkeeper.resource.CreateResourceResponse
+	4,  // 28: gophkeeper.resource.ResourceService.GetResource:output_type -> gophkeeper.resource.GetResourceResponse
+	4,  // 29: gophkeeper.resource.ResourceService.GetResourceByName:output_type -> gophkeeper.resource.GetResourceResponse
+	6,  // 30: gophkeeper.resource.ResourceService.ListResources:output_type -> gophkeeper.resource.ListResourcesResponse
+	8,  // 31: gophkeeper.resource.ResourceService.UpdateResource:output_type -> gophkeeper.resource.UpdateResourceResponse
+	10, // 32: gophkeeper.resource.ResourceService.RenameResource:output_type -> gophkeeper.resource.RenameResourceResponse
+	12, // 33: gophkeeper.resource.ResourceService.MoveFolder:output_type -> gophkeeper.resource.MoveFolderResponse
+	14, // 34: gophkeeper.resource.ResourceService.DeleteResource:output_type -> gophkeeper.resource.DeleteResourceResponse
+	16, // 35: gophkeeper.resource.ResourceService.ListTrash:output_type -> gophkeeper.resource.ListTrashResponse
+	18, // 36: gophkeeper.resource.ResourceService.RestoreResource:output_type -> gophkeeper.resource.RestoreResourceResponse
+	20, // 37: gophkeeper.resource.ResourceService.PurgeResource:output_type -> gophkeeper.resource.PurgeResourceResponse
+	23, // 38: gophkeeper.resource.ResourceService.AddAttachment:output_type -> gophkeeper.resource.AddAttachmentResponse
+	25, // 39: gophkeeper.resource.ResourceService.ListAttachments:output_type -> gophkeeper.resource.ListAttachmentsResponse
+	21, // 40: gophkeeper.resource.ResourceService.GetAttachment:output_type -> gophkeeper.resource.Attachment
+	28, // 41: gophkeeper.resource.ResourceService.DeleteAttachment:output_type -> gophkeeper.resource.DeleteAttachmentResponse
+	32, // 42: gophkeeper.resource.ResourceService.ListResourceTypes:output_type -> gophkeeper.resource.ListResourceTypesResponse
+	34, // 43: gophkeeper.resource.ResourceService.DefineResourceType:output_type -> gophkeeper.resource.DefineResourceTypeResponse
+	36, // 44: gophkeeper.resource.ResourceService.DeleteResourceType:output_type -> gophkeeper.resource.DeleteResourceTypeResponse
+	38, // 45: gophkeeper.resource.ResourceService.ListExpiring:output_type -> gophkeeper.resource.ListExpiringResponse
+	27, // [27:46] is the sub-list for method output_type
+	8,  // [8:27] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_resource_proto_init() }
@@ -755,7 +2643,7 @@ func file_resource_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_proto_rawDesc), len(file_resource_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
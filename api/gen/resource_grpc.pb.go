@@ -20,12 +20,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ResourceService_CreateResource_FullMethodName    = "/gophkeeper.resource.ResourceService/CreateResource"
-	ResourceService_GetResource_FullMethodName       = "/gophkeeper.resource.ResourceService/GetResource"
-	ResourceService_GetResourceByName_FullMethodName = "/gophkeeper.resource.ResourceService/GetResourceByName"
-	ResourceService_ListResources_FullMethodName     = "/gophkeeper.resource.ResourceService/ListResources"
-	ResourceService_UpdateResource_FullMethodName    = "/gophkeeper.resource.ResourceService/UpdateResource"
-	ResourceService_DeleteResource_FullMethodName    = "/gophkeeper.resource.ResourceService/DeleteResource"
+	ResourceService_CreateResource_FullMethodName     = "/gophkeeper.resource.ResourceService/CreateResource"
+	ResourceService_GetResource_FullMethodName        = "/gophkeeper.resource.ResourceService/GetResource"
+	ResourceService_GetResourceByName_FullMethodName  = "/gophkeeper.resource.ResourceService/GetResourceByName"
+	ResourceService_ListResources_FullMethodName      = "/gophkeeper.resource.ResourceService/ListResources"
+	ResourceService_UpdateResource_FullMethodName     = "/gophkeeper.resource.ResourceService/UpdateResource"
+	ResourceService_RenameResource_FullMethodName     = "/gophkeeper.resource.ResourceService/RenameResource"
+	ResourceService_MoveFolder_FullMethodName         = "/gophkeeper.resource.ResourceService/MoveFolder"
+	ResourceService_DeleteResource_FullMethodName     = "/gophkeeper.resource.ResourceService/DeleteResource"
+	ResourceService_ListTrash_FullMethodName          = "/gophkeeper.resource.ResourceService/ListTrash"
+	ResourceService_RestoreResource_FullMethodName    = "/gophkeeper.resource.ResourceService/RestoreResource"
+	ResourceService_PurgeResource_FullMethodName      = "/gophkeeper.resource.ResourceService/PurgeResource"
+	ResourceService_AddAttachment_FullMethodName      = "/gophkeeper.resource.ResourceService/AddAttachment"
+	ResourceService_ListAttachments_FullMethodName    = "/gophkeeper.resource.ResourceService/ListAttachments"
+	ResourceService_GetAttachment_FullMethodName      = "/gophkeeper.resource.ResourceService/GetAttachment"
+	ResourceService_DeleteAttachment_FullMethodName   = "/gophkeeper.resource.ResourceService/DeleteAttachment"
+	ResourceService_ListResourceTypes_FullMethodName  = "/gophkeeper.resource.ResourceService/ListResourceTypes"
+	ResourceService_DefineResourceType_FullMethodName = "/gophkeeper.resource.ResourceService/DefineResourceType"
+	ResourceService_DeleteResourceType_FullMethodName = "/gophkeeper.resource.ResourceService/DeleteResourceType"
+	ResourceService_ListExpiring_FullMethodName       = "/gophkeeper.resource.ResourceService/ListExpiring"
 )
 
 // ResourceServiceClient is the client API for ResourceService service.
@@ -37,7 +50,20 @@ type ResourceServiceClient interface {
 	GetResourceByName(ctx context.Context, in *GetResourceByNameRequest, opts ...grpc.CallOption) (*GetResourceResponse, error)
 	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
 	UpdateResource(ctx context.Context, in *UpdateResourceRequest, opts ...grpc.CallOption) (*UpdateResourceResponse, error)
+	RenameResource(ctx context.Context, in *RenameResourceRequest, opts ...grpc.CallOption) (*RenameResourceResponse, error)
+	MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*MoveFolderResponse, error)
 	DeleteResource(ctx context.Context, in *DeleteResourceRequest, opts ...grpc.CallOption) (*DeleteResourceResponse, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreResource(ctx context.Context, in *RestoreResourceRequest, opts ...grpc.CallOption) (*RestoreResourceResponse, error)
+	PurgeResource(ctx context.Context, in *PurgeResourceRequest, opts ...grpc.CallOption) (*PurgeResourceResponse, error)
+	AddAttachment(ctx context.Context, in *AddAttachmentRequest, opts ...grpc.CallOption) (*AddAttachmentResponse, error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+	ListResourceTypes(ctx context.Context, in *ListResourceTypesRequest, opts ...grpc.CallOption) (*ListResourceTypesResponse, error)
+	DefineResourceType(ctx context.Context, in *DefineResourceTypeRequest, opts ...grpc.CallOption) (*DefineResourceTypeResponse, error)
+	DeleteResourceType(ctx context.Context, in *DeleteResourceTypeRequest, opts ...grpc.CallOption) (*DeleteResourceTypeResponse, error)
+	ListExpiring(ctx context.Context, in *ListExpiringRequest, opts ...grpc.CallOption) (*ListExpiringResponse, error)
 }
 
 type resourceServiceClient struct {
@@ -98,6 +124,26 @@ func (c *resourceServiceClient) UpdateResource(ctx context.Context, in *UpdateRe
 	return out, nil
 }
 
+func (c *resourceServiceClient) RenameResource(ctx context.Context, in *RenameResourceRequest, opts ...grpc.CallOption) (*RenameResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameResourceResponse)
+	err := c.cc.Invoke(ctx, ResourceService_RenameResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*MoveFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveFolderResponse)
+	err := c.cc.Invoke(ctx, ResourceService_MoveFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceServiceClient) DeleteResource(ctx context.Context, in *DeleteResourceRequest, opts ...grpc.CallOption) (*DeleteResourceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteResourceResponse)
@@ -108,6 +154,116 @@ func (c *resourceServiceClient) DeleteResource(ctx context.Context, in *DeleteRe
 	return out, nil
 }
 
+func (c *resourceServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) RestoreResource(ctx context.Context, in *RestoreResourceRequest, opts ...grpc.CallOption) (*RestoreResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreResourceResponse)
+	err := c.cc.Invoke(ctx, ResourceService_RestoreResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) PurgeResource(ctx context.Context, in *PurgeResourceRequest, opts ...grpc.CallOption) (*PurgeResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeResourceResponse)
+	err := c.cc.Invoke(ctx, ResourceService_PurgeResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) AddAttachment(ctx context.Context, in *AddAttachmentRequest, opts ...grpc.CallOption) (*AddAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAttachmentResponse)
+	err := c.cc.Invoke(ctx, ResourceService_AddAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, ResourceService_GetAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, ResourceService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListResourceTypes(ctx context.Context, in *ListResourceTypesRequest, opts ...grpc.CallOption) (*ListResourceTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResourceTypesResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ListResourceTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) DefineResourceType(ctx context.Context, in *DefineResourceTypeRequest, opts ...grpc.CallOption) (*DefineResourceTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefineResourceTypeResponse)
+	err := c.cc.Invoke(ctx, ResourceService_DefineResourceType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) DeleteResourceType(ctx context.Context, in *DeleteResourceTypeRequest, opts ...grpc.CallOption) (*DeleteResourceTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResourceTypeResponse)
+	err := c.cc.Invoke(ctx, ResourceService_DeleteResourceType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListExpiring(ctx context.Context, in *ListExpiringRequest, opts ...grpc.CallOption) (*ListExpiringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpiringResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ListExpiring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResourceServiceServer is the server API for ResourceService service.
 // All implementations must embed UnimplementedResourceServiceServer
 // for forward compatibility.
@@ -117,7 +273,20 @@ type ResourceServiceServer interface {
 	GetResourceByName(context.Context, *GetResourceByNameRequest) (*GetResourceResponse, error)
 	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
 	UpdateResource(context.Context, *UpdateResourceRequest) (*UpdateResourceResponse, error)
+	RenameResource(context.Context, *RenameResourceRequest) (*RenameResourceResponse, error)
+	MoveFolder(context.Context, *MoveFolderRequest) (*MoveFolderResponse, error)
 	DeleteResource(context.Context, *DeleteResourceRequest) (*DeleteResourceResponse, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreResource(context.Context, *RestoreResourceRequest) (*RestoreResourceResponse, error)
+	PurgeResource(context.Context, *PurgeResourceRequest) (*PurgeResourceResponse, error)
+	AddAttachment(context.Context, *AddAttachmentRequest) (*AddAttachmentResponse, error)
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	GetAttachment(context.Context, *GetAttachmentRequest) (*Attachment, error)
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	ListResourceTypes(context.Context, *ListResourceTypesRequest) (*ListResourceTypesResponse, error)
+	DefineResourceType(context.Context, *DefineResourceTypeRequest) (*DefineResourceTypeResponse, error)
+	DeleteResourceType(context.Context, *DeleteResourceTypeRequest) (*DeleteResourceTypeResponse, error)
+	ListExpiring(context.Context, *ListExpiringRequest) (*ListExpiringResponse, error)
 	mustEmbedUnimplementedResourceServiceServer()
 }
 
@@ -143,9 +312,48 @@ func (UnimplementedResourceServiceServer) ListResources(context.Context, *ListRe
 func (UnimplementedResourceServiceServer) UpdateResource(context.Context, *UpdateResourceRequest) (*UpdateResourceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateResource not implemented")
 }
+func (UnimplementedResourceServiceServer) RenameResource(context.Context, *RenameResourceRequest) (*RenameResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameResource not implemented")
+}
+func (UnimplementedResourceServiceServer) MoveFolder(context.Context, *MoveFolderRequest) (*MoveFolderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveFolder not implemented")
+}
 func (UnimplementedResourceServiceServer) DeleteResource(context.Context, *DeleteResourceRequest) (*DeleteResourceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteResource not implemented")
 }
+func (UnimplementedResourceServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedResourceServiceServer) RestoreResource(context.Context, *RestoreResourceRequest) (*RestoreResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreResource not implemented")
+}
+func (UnimplementedResourceServiceServer) PurgeResource(context.Context, *PurgeResourceRequest) (*PurgeResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeResource not implemented")
+}
+func (UnimplementedResourceServiceServer) AddAttachment(context.Context, *AddAttachmentRequest) (*AddAttachmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddAttachment not implemented")
+}
+func (UnimplementedResourceServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedResourceServiceServer) GetAttachment(context.Context, *GetAttachmentRequest) (*Attachment, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAttachment not implemented")
+}
+func (UnimplementedResourceServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedResourceServiceServer) ListResourceTypes(context.Context, *ListResourceTypesRequest) (*ListResourceTypesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListResourceTypes not implemented")
+}
+func (UnimplementedResourceServiceServer) DefineResourceType(context.Context, *DefineResourceTypeRequest) (*DefineResourceTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DefineResourceType not implemented")
+}
+func (UnimplementedResourceServiceServer) DeleteResourceType(context.Context, *DeleteResourceTypeRequest) (*DeleteResourceTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteResourceType not implemented")
+}
+func (UnimplementedResourceServiceServer) ListExpiring(context.Context, *ListExpiringRequest) (*ListExpiringResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExpiring not implemented")
+}
 func (UnimplementedResourceServiceServer) mustEmbedUnimplementedResourceServiceServer() {}
 func (UnimplementedResourceServiceServer) testEmbeddedByValue()                         {}
 
@@ -257,6 +465,42 @@ func _ResourceService_UpdateResource_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceService_RenameResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).RenameResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_RenameResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).RenameResource(ctx, req.(*RenameResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_MoveFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).MoveFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_MoveFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).MoveFolder(ctx, req.(*MoveFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceService_DeleteResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteResourceRequest)
 	if err := dec(in); err != nil {
@@ -275,6 +519,204 @@ func _ResourceService_DeleteResource_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_RestoreResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).RestoreResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_RestoreResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).RestoreResource(ctx, req.(*RestoreResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_PurgeResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).PurgeResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_PurgeResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).PurgeResource(ctx, req.(*PurgeResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_AddAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).AddAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_AddAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).AddAttachment(ctx, req.(*AddAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_GetAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).GetAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_GetAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).GetAttachment(ctx, req.(*GetAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListResourceTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourceTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListResourceTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ListResourceTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListResourceTypes(ctx, req.(*ListResourceTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_DefineResourceType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefineResourceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DefineResourceType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_DefineResourceType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DefineResourceType(ctx, req.(*DefineResourceTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_DeleteResourceType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResourceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DeleteResourceType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_DeleteResourceType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DeleteResourceType(ctx, req.(*DeleteResourceTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListExpiring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListExpiring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ListExpiring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListExpiring(ctx, req.(*ListExpiringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ResourceService_ServiceDesc is the grpc.ServiceDesc for ResourceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -302,10 +744,62 @@ var ResourceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateResource",
 			Handler:    _ResourceService_UpdateResource_Handler,
 		},
+		{
+			MethodName: "RenameResource",
+			Handler:    _ResourceService_RenameResource_Handler,
+		},
+		{
+			MethodName: "MoveFolder",
+			Handler:    _ResourceService_MoveFolder_Handler,
+		},
 		{
 			MethodName: "DeleteResource",
 			Handler:    _ResourceService_DeleteResource_Handler,
 		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _ResourceService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreResource",
+			Handler:    _ResourceService_RestoreResource_Handler,
+		},
+		{
+			MethodName: "PurgeResource",
+			Handler:    _ResourceService_PurgeResource_Handler,
+		},
+		{
+			MethodName: "AddAttachment",
+			Handler:    _ResourceService_AddAttachment_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _ResourceService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "GetAttachment",
+			Handler:    _ResourceService_GetAttachment_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _ResourceService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "ListResourceTypes",
+			Handler:    _ResourceService_ListResourceTypes_Handler,
+		},
+		{
+			MethodName: "DefineResourceType",
+			Handler:    _ResourceService_DefineResourceType_Handler,
+		},
+		{
+			MethodName: "DeleteResourceType",
+			Handler:    _ResourceService_DeleteResourceType_Handler,
+		},
+		{
+			MethodName: "ListExpiring",
+			Handler:    _ResourceService_ListExpiring_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "resource.proto",
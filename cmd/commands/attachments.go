@@ -0,0 +1,272 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+)
+
+// attachCmd represents the attach command
+var attachCmd = &cobra.Command{
+	Use:   "attach <name>",
+	Short: "Attach files to a secret",
+	Long: `Attach files to a secret. File names and contents are encrypted.
+
+Attachments are deleted together with the secret when it is purged from trash.
+
+Examples:
+  gophkeeper attach github -f recovery-codes.pdf
+  gophkeeper attach licenses/ide -f license.key -f invoice.pdf`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		files, _ := cmd.Flags().GetStringArray("file")
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		resource, err := findResource(cryptoService, name)
+		if err != nil {
+			fmt.Printf("✗ Secret '%s' not found: %v\n", name, err)
+			return
+		}
+
+		for _, filePath := range files {
+			id, err := attachFile(cryptoService, resource.GetId(), filePath)
+			if err != nil {
+				fmt.Printf("✗ Failed to attach '%s': %v\n", filePath, err)
+				return
+			}
+			fmt.Printf("✓ Attached '%s' to '%s' (ID: %d)\n", filepath.Base(filePath), name, id)
+		}
+	},
+}
+
+// attachmentsCmd represents the attachments command
+var attachmentsCmd = &cobra.Command{
+	Use:   "attachments <name>",
+	Short: "List or save files attached to a secret",
+	Long: `List files attached to a secret, or save one of them with --save.
+
+An attachment is selected by its file name or ID. It is saved under its
+file name in the current directory unless --out is given.
+
+Examples:
+  gophkeeper attachments github
+  gophkeeper attachments github --save recovery-codes.pdf
+  gophkeeper attachments github --save 12 --out ~/Downloads/codes.pdf`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		save, _ := cmd.Flags().GetString("save")
+		out, _ := cmd.Flags().GetString("out")
+		force, _ := cmd.Flags().GetBool("force")
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		resource, err := findResource(cryptoService, name)
+		if err != nil {
+			fmt.Printf("✗ Secret '%s' not found: %v\n", name, err)
+			return
+		}
+
+		attachments, err := resourceClient.ListAttachments(resource.GetId())
+		if err != nil {
+			fmt.Printf("✗ Failed to list attachments: %v\n", err)
+			return
+		}
+
+		if save == "" {
+			printAttachments(cryptoService, attachments)
+			return
+		}
+
+		attachment, err := findAttachment(cryptoService, attachments, save)
+		if err != nil {
+			fmt.Printf("✗ %v\n", err)
+			return
+		}
+		if out == "" {
+			out = filepath.Base(attachmentName(cryptoService, attachment))
+		}
+
+		size, err := saveAttachment(cryptoService, attachment.GetId(), out, force)
+		if err != nil {
+			fmt.Printf("✗ Failed to save attachment: %v\n", err)
+			return
+		}
+		fmt.Printf("✓ Saved %s to '%s'\n", formatSize(size), out)
+	},
+}
+
+// detachCmd represents the detach command
+var detachCmd = &cobra.Command{
+	Use:   "detach <name> <file>",
+	Short: "Delete a file attached to a secret",
+	Long: `Permanently delete a file attached to a secret.
+The attachment is selected by its file name or ID.
+
+Examples:
+  gophkeeper detach github recovery-codes.pdf`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		name, file := args[0], args[1]
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		resource, err := findResource(cryptoService, name)
+		if err != nil {
+			fmt.Printf("✗ Secret '%s' not found: %v\n", name, err)
+			return
+		}
+
+		attachments, err := resourceClient.ListAttachments(resource.GetId())
+		if err != nil {
+			fmt.Printf("✗ Failed to list attachments: %v\n", err)
+			return
+		}
+
+		attachment, err := findAttachment(cryptoService, attachments, file)
+		if err != nil {
+			fmt.Printf("✗ %v\n", err)
+			return
+		}
+
+		if err := resourceClient.DeleteAttachment(attachment.GetId()); err != nil {
+			fmt.Printf("✗ Failed to delete attachment: %v\n", err)
+			return
+		}
+		fmt.Printf("✓ Attachment '%s' deleted from '%s'\n", attachmentName(cryptoService, attachment), name)
+	},
+}
+
+// attachFile encrypts a file with its name, size, permissions and modification time and attaches it to a resource
+// Returns the ID of the attachment
+func attachFile(cryptoService *crypto.CryptoService, resourceID int64, filePath string) (int64, error) {
+	data, fileInfo, err := readFile(filePath)
+	if err != nil {
+		return 0, err
+	}
+
+	encryptedName, err := cryptoService.EncryptName(fileInfo.Name)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encrypt name: %w", err)
+	}
+	encryptedData, err := cryptoService.EncryptData(data)
+	if err != nil {
+		return 0, fmt.Errorf("encryption failed: %w", err)
+	}
+	encryptedMetadata, err := encryptMetadata(cryptoService, &models.ResourceMetadata{Size: int64(len(data)), File: fileInfo})
+	if err != nil {
+		return 0, fmt.Errorf("encryption failed: %w", err)
+	}
+
+	return resourceClient.AddAttachment(resourceID, encryptedName, encryptedData, encryptedMetadata)
+}
+
+// saveAttachment downloads and decrypts an attachment into a file at path with its original permissions
+// Returns the number of bytes written
+func saveAttachment(cryptoService *crypto.CryptoService, id int64, path string, force bool) (int64, error) {
+	attachment, err := resourceClient.GetAttachment(id)
+	if err != nil {
+		return 0, err
+	}
+
+	data, err := cryptoService.DecryptData(attachment.GetData())
+	if err != nil {
+		return 0, fmt.Errorf("decryption failed: %w", err)
+	}
+
+	metadata, err := decryptMetadata(cryptoService, attachment.GetMetadata())
+	if err != nil {
+		return 0, fmt.Errorf("failed to decrypt metadata: %w", err)
+	}
+
+	if err := writeFile(path, data, metadata.File, force); err != nil {
+		return 0, err
+	}
+	return int64(len(data)), nil
+}
+
+// findAttachment selects an attachment by its decrypted file name or ID
+func findAttachment(cryptoService *crypto.CryptoService, attachments []*pb.Attachment, nameOrID string) (*pb.Attachment, error) {
+	id, idErr := strconv.ParseInt(nameOrID, 10, 64)
+
+	var found *pb.Attachment
+	for _, a := range attachments {
+		if idErr == nil && a.GetId() == id {
+			return a, nil
+		}
+		if attachmentName(cryptoService, a) == nameOrID {
+			if found != nil {
+				return nil, fmt.Errorf("several attachments are named '%s', select one by ID", nameOrID)
+			}
+			found = a
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("attachment '%s' not found", nameOrID)
+	}
+	return found, nil
+}
+
+// attachmentName returns the decrypted file name of an attachment, or its ID if it cannot be decrypted
+func attachmentName(cryptoService *crypto.CryptoService, attachment *pb.Attachment) string {
+	if name, err := cryptoService.DecryptName(attachment.GetEncryptedName()); err == nil {
+		return name
+	}
+	return fmt.Sprintf("<encrypted #%d>", attachment.GetId())
+}
+
+func printAttachments(cryptoService *crypto.CryptoService, attachments []*pb.Attachment) {
+	if len(attachments) == 0 {
+		fmt.Println("No attachments found.")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tSIZE\tADDED")
+	for _, a := range attachments {
+		size := a.GetSize()
+		if metadata, err := decryptMetadata(cryptoService, a.GetMetadata()); err == nil && metadata.Size > 0 {
+			size = metadata.Size
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n",
+			a.GetId(), attachmentName(cryptoService, a), formatSize(size), formatTimestamp(a.GetCreatedAt()))
+	}
+	w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(attachCmd)
+	attachCmd.Flags().StringArrayP("file", "f", nil, "Path to the file to attach, may be repeated")
+	attachCmd.MarkFlagRequired("file")
+
+	rootCmd.AddCommand(attachmentsCmd)
+	attachmentsCmd.Flags().String("save", "", "Save the attachment with this file name or ID")
+	attachmentsCmd.Flags().String("out", "", "Path to save the attachment to (defaults to its file name)")
+	attachmentsCmd.Flags().Bool("force", false, "Overwrite an existing file")
+
+	rootCmd.AddCommand(detachCmd)
+}
@@ -0,0 +1,252 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+// auditReport is the result of the audit command
+type auditReport struct {
+	Score        int            `json:"score"`   // percentage of audited secrets without issues
+	Audited      int            `json:"audited"` // number of audited credentials and cards
+	Reused       [][]string     `json:"reused"`  // names of credentials sharing a password
+	Weak         []weakPassword `json:"weak"`
+	Old          []oldPassword  `json:"old"`
+	ExpiredCards []string       `json:"expired_cards"`
+}
+
+type weakPassword struct {
+	Name     string   `json:"name"`
+	Score    int      `json:"score"`
+	Entropy  float64  `json:"entropy"`
+	Patterns []string `json:"patterns,omitempty"`
+}
+
+type oldPassword struct {
+	Name      string `json:"name"`
+	ChangedAt string `json:"changed_at"`
+	AgeDays   int    `json:"age_days"`
+}
+
+// auditCmd represents the audit command
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Report reused, weak and old passwords and expired cards",
+	Long: `Check the health of the vault: reused passwords, passwords that are easy to guess,
+passwords not changed for a long time and expired cards.
+
+Secrets are decrypted locally only, passwords are never sent anywhere or printed.
+The score is the percentage of credentials and cards without issues.
+
+Examples:
+  gophkeeper audit
+  gophkeeper audit --max-age 180 --min-score 4
+  gophkeeper audit -o json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		maxAge, _ := cmd.Flags().GetInt("max-age")
+		minScore, _ := cmd.Flags().GetInt("min-score")
+		output, _ := cmd.Flags().GetString("output")
+
+		if output != "table" && output != "json" {
+			fmt.Println("✗ Invalid output format. Use: table or json")
+			return
+		}
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		report, err := auditVault(cryptoService, maxAge, minScore, time.Now())
+		if err != nil {
+			fmt.Printf("✗ %v\n", err)
+			return
+		}
+
+		if output == "json" {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(report); err != nil {
+				fmt.Fprintf(os.Stderr, "✗ Failed to encode JSON: %v\n", err)
+			}
+			return
+		}
+		printAuditReport(report, maxAge)
+	},
+}
+
+// auditVault decrypts all credentials and cards and checks them
+// A credential is weak if its strength score is below minScore and old if its password was not changed for maxAge days
+func auditVault(cryptoService *crypto.CryptoService, maxAge, minScore int, now time.Time) (*auditReport, error) {
+	report := &auditReport{Reused: [][]string{}, Weak: []weakPassword{}, Old: []oldPassword{}, ExpiredCards: []string{}}
+	unhealthy := make(map[int64]bool)
+
+	credentials, err := resourceClient.ListAllResources(&pb.ListResourcesRequest{Type: proto.String(string(models.TypeCredentials))})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+	revealNames(cryptoService, credentials)
+
+	byPassword := make(map[string][]*pb.GetResourceResponse)
+	for _, r := range credentials {
+		resource, err := resourceClient.GetResource(r.GetId())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get '%s': %w", r.GetName(), err)
+		}
+		credential, err := decryptCredential(cryptoService, resource.GetData())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠ Skipping '%s': %v\n", r.GetName(), err)
+			continue
+		}
+		report.Audited++
+
+		if credential.Password != "" {
+			byPassword[credential.Password] = append(byPassword[credential.Password], r)
+		}
+
+		strength := crypto.EstimateStrength(credential.Password, credential.Username, r.GetName())
+		if strength.Score < minScore {
+			unhealthy[r.GetId()] = true
+			report.Weak = append(report.Weak, weakPassword{
+				Name:     r.GetName(),
+				Score:    strength.Score,
+				Entropy:  strength.Entropy,
+				Patterns: strength.Patterns,
+			})
+		}
+
+		changedAt, ok := passwordChangedAt(credential, resource)
+		if !ok {
+			continue
+		}
+		if age := int(now.Sub(changedAt).Hours() / 24); maxAge > 0 && age >= maxAge {
+			unhealthy[r.GetId()] = true
+			report.Old = append(report.Old, oldPassword{
+				Name:      r.GetName(),
+				ChangedAt: changedAt.UTC().Format(time.RFC3339),
+				AgeDays:   age,
+			})
+		}
+	}
+
+	for _, group := range byPassword {
+		if len(group) < 2 {
+			continue
+		}
+		names := make([]string, 0, len(group))
+		for _, r := range group {
+			unhealthy[r.GetId()] = true
+			names = append(names, r.GetName())
+		}
+		sort.Strings(names)
+		report.Reused = append(report.Reused, names)
+	}
+	sort.Slice(report.Reused, func(i, j int) bool { return report.Reused[i][0] < report.Reused[j][0] })
+
+	cards, err := resourceClient.ListAllResources(&pb.ListResourcesRequest{Type: proto.String(string(models.TypeCard))})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cards: %w", err)
+	}
+	revealNames(cryptoService, cards)
+
+	for _, r := range cards {
+		resource, err := resourceClient.GetResource(r.GetId())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get '%s': %w", r.GetName(), err)
+		}
+		card, err := decryptCard(cryptoService, resource.GetData())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠ Skipping '%s': %v\n", r.GetName(), err)
+			continue
+		}
+		report.Audited++
+
+		if card.IsExpired(now) {
+			unhealthy[r.GetId()] = true
+			report.ExpiredCards = append(report.ExpiredCards, r.GetName())
+		}
+	}
+
+	report.Score = 100
+	if report.Audited > 0 {
+		report.Score = 100 * (report.Audited - len(unhealthy)) / report.Audited
+	}
+	return report, nil
+}
+
+// passwordChangedAt returns when the current password of a credential was set
+// Edits of other fields do not count: the newest password history entry is used,
+// then the last data change of the secret and then its creation
+func passwordChangedAt(credential *models.Credential, resource *pb.GetResourceResponse) (time.Time, bool) {
+	if len(credential.PasswordHistory) > 0 {
+		return credential.PasswordHistory[0].ChangedAt, true
+	}
+	for _, value := range []string{resource.GetRotatedAt(), resource.GetCreatedAt()} {
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func printAuditReport(report *auditReport, maxAge int) {
+	if len(report.Reused) > 0 {
+		fmt.Printf("⚠ Reused passwords (%d groups):\n", len(report.Reused))
+		for _, names := range report.Reused {
+			fmt.Printf("    %s\n", strings.Join(names, ", "))
+		}
+	}
+
+	if len(report.Weak) > 0 {
+		fmt.Printf("⚠ Weak passwords (%d):\n", len(report.Weak))
+		for _, w := range report.Weak {
+			line := fmt.Sprintf("    %s: score %d/4, ~%.0f bits", w.Name, w.Score, w.Entropy)
+			if len(w.Patterns) > 0 {
+				line += " (" + strings.Join(w.Patterns, ", ") + ")"
+			}
+			fmt.Println(line)
+		}
+	}
+
+	if len(report.Old) > 0 {
+		fmt.Printf("⚠ Passwords not changed for %d days or more (%d):\n", maxAge, len(report.Old))
+		for _, o := range report.Old {
+			fmt.Printf("    %s: %d days, changed %s\n", o.Name, o.AgeDays, formatTimestamp(o.ChangedAt))
+		}
+	}
+
+	if len(report.ExpiredCards) > 0 {
+		fmt.Printf("⚠ Expired cards (%d):\n", len(report.ExpiredCards))
+		for _, name := range report.ExpiredCards {
+			fmt.Printf("    %s\n", name)
+		}
+	}
+
+	mark := "✓"
+	if report.Score < 100 {
+		mark = "⚠"
+	}
+	fmt.Printf("%s Score: %d/100 (%d credentials and cards audited)\n", mark, report.Score, report.Audited)
+}
+
+func init() {
+	rootCmd.AddCommand(auditCmd)
+	auditCmd.Flags().Int("max-age", 365, "Report passwords not changed for this many days, 0 to disable")
+	auditCmd.Flags().Int("min-score", 3, "Report passwords with a strength score below this, from 0 to 4")
+	auditCmd.Flags().StringP("output", "o", "table", "Output format: table | json")
+}
@@ -0,0 +1,139 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/client"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+// breachedPassword is a credential whose password was found in the breach dataset
+type breachedPassword struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"` // number of times the password appeared in breaches
+}
+
+// breachCheckCmd represents the breach-check command
+var breachCheckCmd = &cobra.Command{
+	Use:   "breach-check",
+	Short: "Check passwords against a local copy of Pwned Passwords",
+	Long: `Check the passwords of all credentials against a locally downloaded copy of the
+Pwned Passwords dataset. Passwords are hashed with SHA-1 and looked up on disk only,
+nothing is sent over the network.
+
+The dataset is either a directory of range files as downloaded by PwnedPasswordsDownloader
+(5BAA6.txt, ...) or a binary file of sorted records: 20-byte SHA-1 hash and big-endian uint32 count.
+Its path is taken from --dataset, "breach_dataset_path" in ~/.gophkeeper/config.json
+or defaults to ~/.gophkeeper/pwned-passwords.
+
+Examples:
+  gophkeeper breach-check
+  gophkeeper breach-check --dataset /data/pwnedpasswords
+  gophkeeper breach-check -o json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		datasetPath, _ := cmd.Flags().GetString("dataset")
+		output, _ := cmd.Flags().GetString("output")
+
+		if output != "table" && output != "json" {
+			fmt.Println("✗ Invalid output format. Use: table or json")
+			return
+		}
+
+		if datasetPath == "" {
+			datasetPath = clientConfig.BreachDatasetPath
+		}
+		if datasetPath == "" {
+			datasetPath = client.DefaultBreachDatasetPath()
+		}
+
+		dataset, err := client.OpenBreachDataset(datasetPath)
+		if err != nil {
+			if errors.Is(err, client.ErrBreachDatasetNotFound) {
+				fmt.Printf("✗ %v\n", err)
+				fmt.Println("Download it with PwnedPasswordsDownloader and set --dataset or breach_dataset_path in ~/.gophkeeper/config.json")
+				return
+			}
+			fmt.Printf("✗ Failed to open dataset: %v\n", err)
+			return
+		}
+		defer dataset.Close()
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		resources, err := resourceClient.ListAllResources(&pb.ListResourcesRequest{Type: proto.String(string(models.TypeCredentials))})
+		if err != nil {
+			fmt.Printf("✗ Failed to list credentials: %v\n", err)
+			return
+		}
+		revealNames(cryptoService, resources)
+
+		breached := []breachedPassword{}
+		checked := 0
+		for _, r := range resources {
+			resource, err := resourceClient.GetResource(r.GetId())
+			if err != nil {
+				fmt.Printf("✗ Failed to get '%s': %v\n", r.GetName(), err)
+				return
+			}
+			credential, err := decryptCredential(cryptoService, resource.GetData())
+			if err != nil || credential.Password == "" {
+				continue
+			}
+
+			count, err := dataset.Count(credential.Password)
+			if err != nil {
+				fmt.Printf("✗ Failed to check '%s': %v\n", r.GetName(), err)
+				return
+			}
+			checked++
+			if count > 0 {
+				breached = append(breached, breachedPassword{Name: r.GetName(), Count: count})
+			}
+		}
+		sort.Slice(breached, func(i, j int) bool { return breached[i].Count > breached[j].Count })
+
+		if output == "json" {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(breached); err != nil {
+				fmt.Fprintf(os.Stderr, "✗ Failed to encode JSON: %v\n", err)
+			}
+			return
+		}
+
+		if len(breached) == 0 {
+			fmt.Printf("✓ None of %d passwords was found in breaches\n", checked)
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tSEEN IN BREACHES")
+		for _, b := range breached {
+			fmt.Fprintf(w, "%s\t%d\n", b.Name, b.Count)
+		}
+		w.Flush()
+		fmt.Printf("⚠ %d of %d passwords were found in breaches, change them\n", len(breached), checked)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(breachCheckCmd)
+	breachCheckCmd.Flags().String("dataset", "", "Path to the Pwned Passwords dataset (default from config or ~/.gophkeeper/pwned-passwords)")
+	breachCheckCmd.Flags().StringP("output", "o", "table", "Output format: table | json")
+}
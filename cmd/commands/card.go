@@ -0,0 +1,140 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+)
+
+// addCardFlags registers flags for the non-secret fields of a card
+// The card number, CVV and PIN are always prompted for and never taken from flags
+func addCardFlags(cmd *cobra.Command) {
+	cmd.Flags().String("holder", "", "Card holder name (card only)")
+	cmd.Flags().String("expiry", "", "Expiry date MM/YY (card only, prompted if omitted)")
+	cmd.Flags().String("issuer", "", "Issuing bank (card) or service (totp)")
+}
+
+// readCard builds a card from flags and interactive prompts and validates it
+// If current is not nil, empty answers keep its values
+func readCard(cmd *cobra.Command, current *models.Card) (*models.Card, error) {
+	card := &models.Card{}
+	if current != nil {
+		*card = *current
+	}
+
+	holder, _ := cmd.Flags().GetString("holder")
+	expiry, _ := cmd.Flags().GetString("expiry")
+	issuer, _ := cmd.Flags().GetString("issuer")
+
+	keepHint := ""
+	if current != nil {
+		keepHint = " (leave empty to keep current)"
+	}
+
+	number, err := promptPassword("Card number" + keepHint + ": ")
+	if err != nil {
+		return nil, err
+	}
+	if number != "" {
+		card.Number = models.NormalizeCardNumber(number)
+	}
+
+	if holder != "" {
+		card.Holder = holder
+	}
+	if issuer != "" {
+		card.Issuer = issuer
+	}
+
+	if expiry == "" {
+		defaultExpiry := ""
+		if current != nil {
+			defaultExpiry = current.Expiry()
+		}
+		if expiry, err = promptLine(withDefault("Expiry (MM/YY)", defaultExpiry)); err != nil {
+			return nil, err
+		}
+	}
+	if expiry != "" {
+		if card.ExpiryMonth, card.ExpiryYear, err = models.ParseCardExpiry(expiry); err != nil {
+			return nil, err
+		}
+	}
+
+	cvv, err := promptPassword("CVV" + keepHint + ": ")
+	if err != nil {
+		return nil, err
+	}
+	if cvv != "" {
+		card.CVV = cvv
+	}
+
+	pin, err := promptPassword("PIN (optional)" + keepHint + ": ")
+	if err != nil {
+		return nil, err
+	}
+	if pin != "" {
+		card.PIN = pin
+	}
+
+	if err := card.Validate(); err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+// decryptCard decrypts a card resource payload
+// Returns an error for resources stored before cards became structured
+func decryptCard(cryptoService *crypto.CryptoService, data []byte) (*models.Card, error) {
+	var card models.Card
+	if err := cryptoService.DecryptJSON(data, &card); err != nil {
+		return nil, err
+	}
+	if card.Number == "" {
+		return nil, models.ErrInvalidCardNumber
+	}
+	return &card, nil
+}
+
+// printCard prints a card, the number, CVV and PIN are masked unless reveal is set
+func printCard(card *models.Card, reveal bool) {
+	number, cvv, pin := card.MaskedNumber(), maskSecret(card.CVV), maskSecret(card.PIN)
+	if reveal {
+		number, cvv, pin = card.Number, card.CVV, card.PIN
+	}
+
+	if card.Holder != "" {
+		fmt.Printf("Holder: %s\n", card.Holder)
+	}
+	fmt.Printf("Number: %s\n", number)
+	fmt.Printf("Brand: %s\n", card.Brand())
+	fmt.Printf("Expiry: %s\n", card.Expiry())
+	if card.CVV != "" {
+		fmt.Printf("CVV: %s\n", cvv)
+	}
+	if card.PIN != "" {
+		fmt.Printf("PIN: %s\n", pin)
+	}
+	if card.Issuer != "" {
+		fmt.Printf("Issuer: %s\n", card.Issuer)
+	}
+}
+
+// warnIfExpired prints a warning to stderr if the card has expired
+func warnIfExpired(card *models.Card) {
+	if card.IsExpired(time.Now()) {
+		fmt.Fprintf(os.Stderr, "⚠ Card expired %s\n", card.Expiry())
+	}
+}
+
+func maskSecret(value string) string {
+	return strings.Repeat("*", len(value))
+}
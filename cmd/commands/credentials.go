@@ -0,0 +1,201 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// addCredentialFlags registers flags for the non-secret fields of a credential
+// Passwords and secret fields are always prompted for and never taken from flags
+func addCredentialFlags(cmd *cobra.Command) {
+	cmd.Flags().String("username", "", "Username (credentials only, prompted if omitted)")
+	cmd.Flags().StringArray("url", nil, "URL, may be repeated (credentials only)")
+	cmd.Flags().String("notes", "", "Notes (credentials only)")
+	cmd.Flags().StringArray("field", nil, "Custom field name=value, may be repeated (credentials and custom types)")
+	cmd.Flags().StringArray("secret-field", nil, "Name of a custom secret field to prompt for, may be repeated (credentials only)")
+}
+
+// readCredential builds a credential from flags and interactive prompts
+// The password is generated instead of prompted for if the command has a set --generate flag
+// If current is not nil, empty answers keep its values
+func readCredential(cmd *cobra.Command, current *models.Credential) (*models.Credential, error) {
+	credential := &models.Credential{}
+	if current != nil {
+		*credential = *current
+		credential.CustomFields = append([]models.CustomField(nil), current.CustomFields...)
+	}
+
+	username, _ := cmd.Flags().GetString("username")
+	urls, _ := cmd.Flags().GetStringArray("url")
+	notes, _ := cmd.Flags().GetString("notes")
+	fields, _ := cmd.Flags().GetStringArray("field")
+	secretFields, _ := cmd.Flags().GetStringArray("secret-field")
+
+	if username != "" {
+		credential.Username = username
+	} else {
+		answer, err := promptLine(withDefault("Username", credential.Username))
+		if err != nil {
+			return nil, err
+		}
+		if answer != "" {
+			credential.Username = answer
+		}
+	}
+
+	var password string
+	var err error
+	if generate, _ := cmd.Flags().GetBool("generate"); generate {
+		// The generated password is never printed, only its strength
+		var entropy float64
+		password, entropy, err = generatePassword(cmd)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("Generated a password of %.0f bits\n", entropy)
+	} else {
+		passwordPrompt := "Password: "
+		if current != nil {
+			passwordPrompt = "Password (leave empty to keep current): "
+		}
+		password, err = promptPassword(passwordPrompt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if password != "" && credential.UsedPassword(password) {
+		fmt.Fprintln(os.Stderr, "⚠ This password was used before")
+	}
+	credential.ChangePassword(password, time.Now())
+	if credential.Password == "" {
+		return nil, fmt.Errorf("password must not be empty")
+	}
+
+	if len(urls) > 0 {
+		credential.URLs = urls
+	}
+	if notes != "" {
+		credential.Notes = notes
+	}
+
+	for _, field := range fields {
+		name, value, ok := strings.Cut(field, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid field %q, expected name=value", field)
+		}
+		credential.SetCustomField(models.CustomField{Name: name, Value: value})
+	}
+
+	for _, name := range secretFields {
+		value, err := promptPassword(name + ": ")
+		if err != nil {
+			return nil, err
+		}
+		credential.SetCustomField(models.CustomField{Name: name, Value: value, Secret: true})
+	}
+
+	return credential, nil
+}
+
+// decryptCredential decrypts a credentials resource payload
+// Returns an error for resources stored before credentials became structured
+func decryptCredential(cryptoService *crypto.CryptoService, data []byte) (*models.Credential, error) {
+	var credential models.Credential
+	if err := cryptoService.DecryptJSON(data, &credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// printCredential prints all fields of a credential
+func printCredential(credential *models.Credential) {
+	fmt.Printf("Username: %s\n", credential.Username)
+	fmt.Printf("Password: %s\n", credential.Password)
+	for _, url := range credential.URLs {
+		fmt.Printf("URL: %s\n", url)
+	}
+	if credential.Notes != "" {
+		fmt.Printf("Notes: %s\n", credential.Notes)
+	}
+	for _, f := range credential.CustomFields {
+		fmt.Printf("%s: %s\n", f.Name, f.Value)
+	}
+	if len(credential.PasswordHistory) > 0 {
+		fmt.Printf("Previous passwords: %d (show with --password-history)\n", len(credential.PasswordHistory))
+	}
+}
+
+// printPasswordHistory prints the previous passwords of a credential, the most recent first
+func printPasswordHistory(credential *models.Credential) {
+	if len(credential.PasswordHistory) == 0 {
+		fmt.Println("No previous passwords.")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "REPLACED\tPASSWORD")
+	for _, entry := range credential.PasswordHistory {
+		fmt.Fprintf(w, "%s\t%s\n", entry.ChangedAt.Local().Format("2006-01-02 15:04"), entry.Password)
+	}
+	w.Flush()
+}
+
+// saveCredential uploads a changed credential, its metadata carries the new size
+// rotated restarts the rotation interval, set it only if the password changed
+func saveCredential(cryptoService *crypto.CryptoService, resource *pb.GetResourceResponse, credential *models.Credential, rotated bool) error {
+	plaintext, err := json.Marshal(credential)
+	if err != nil {
+		return fmt.Errorf("failed to encode credential: %w", err)
+	}
+	encryptedData, err := cryptoService.EncryptData(plaintext)
+	if err != nil {
+		return fmt.Errorf("encryption failed: %w", err)
+	}
+
+	metadata, err := decryptMetadata(cryptoService, resource.GetMetadata())
+	if err != nil {
+		return fmt.Errorf("failed to decrypt metadata: %w", err)
+	}
+	metadata.Size = int64(len(plaintext))
+	encryptedMetadata, err := encryptMetadata(cryptoService, metadata)
+	if err != nil {
+		return fmt.Errorf("encryption failed: %w", err)
+	}
+
+	req := &pb.UpdateResourceRequest{
+		Id:               proto.Int64(resource.GetId()),
+		Data:             encryptedData,
+		Metadata:         encryptedMetadata,
+		ExpectedRevision: proto.Int64(resource.GetRevision()),
+		UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"data", "metadata"}},
+	}
+	if rotated {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "rotated")
+	}
+	_, err = resourceClient.UpdateResourceFields(req)
+	if err != nil {
+		return fmt.Errorf("failed to update '%s': %w", displayName(cryptoService, resource), err)
+	}
+	return nil
+}
+
+func withDefault(prompt, value string) string {
+	if value == "" {
+		return prompt + ": "
+	}
+	return fmt.Sprintf("%s [%s]: ", prompt, value)
+}
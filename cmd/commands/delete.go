@@ -12,28 +12,44 @@ import (
 // deleteCmd represents the delete command
 var deleteCmd = &cobra.Command{
 	Use:   "delete <name>",
-	Short: "Delete a resource by name",
-	Long:  `gophkeeper delete <name>`,
+	Short: "Move a resource to trash by name",
+	Long:  `gophkeeper delete <name> [--revision <n>]`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		name := args[0]
 
-		resource, err := resourceClient.GetResourceByName(name)
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		resource, err := findResource(cryptoService, name)
 		if err != nil {
 			fmt.Printf("✗ Secret '%s' not found: %v\n", name, err)
 			return
 		}
 
-		if err := resourceClient.DeleteResource(resource.GetId()); err != nil {
+		expectedRevision := resource.GetRevision()
+		if cmd.Flags().Changed("revision") {
+			expectedRevision, _ = cmd.Flags().GetInt64("revision")
+		}
+
+		if err := resourceClient.DeleteResource(resource.GetId(), expectedRevision); err != nil {
+			if isRevisionConflict(err) {
+				printConflict(cryptoService, resource, expectedRevision)
+				return
+			}
 			fmt.Printf("✗ Failed to delete secret: %v\n", err)
 			return
 		}
 
-		fmt.Printf("✓ Secret '%s' deleted successfully\n", name)
+		fmt.Printf("✓ Secret '%s' moved to trash\n", name)
+		fmt.Println("Use 'gophkeeper trash restore' to undo.")
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(deleteCmd)
-
+	deleteCmd.Flags().Int64("revision", 0, "Expected revision (defaults to the current one)")
 }
@@ -0,0 +1,230 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+// dockerFolder is the folder registry credentials are stored in
+const dockerFolder = "docker"
+
+// errDockerCredentialsNotFound is the message Docker expects when a helper has no credentials
+var errDockerCredentialsNotFound = errors.New("credentials not found in native keychain")
+
+// dockerCredential is the JSON payload of Docker's credential helper protocol
+type dockerCredential struct {
+	ServerURL string `json:"ServerURL"`
+	Username  string `json:"Username"`
+	Secret    string `json:"Secret"`
+}
+
+// dockerEntry is a stored registry credential
+type dockerEntry struct {
+	resource   *pb.GetResourceResponse
+	credential *models.Credential
+}
+
+// dockerCredentialCmd represents the docker-credential command
+var dockerCredentialCmd = &cobra.Command{
+	Use:   "docker-credential <store|get|erase|list>",
+	Short: "Docker credential helper backed by the vault",
+	Long: `Docker credential helper backed by the vault.
+
+Registry credentials are stored as credentials under docker/<registry>, e.g.
+docker/index.docker.io/v1, so ~/.docker/config.json keeps no passwords or tokens.
+
+Docker runs the docker-credential-gophkeeper binary, which is this command.
+Build it, put it on the PATH and set it in ~/.docker/config.json:
+  go build -o /usr/local/bin/docker-credential-gophkeeper ./cmd/docker-credential-gophkeeper
+  { "credsStore": "gophkeeper" }
+
+The vault must be unlocked for Docker to get credentials.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Docker reads errors from stdout
+		if err := runDockerCredentialHelper(args[0], os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stdout, err)
+			os.Exit(1)
+		}
+	},
+}
+
+// runDockerCredentialHelper performs one operation of the protocol
+// store reads a JSON credential, get and erase read a server URL, list reads nothing
+func runDockerCredentialHelper(operation string, in io.Reader, out io.Writer) error {
+	if operation != "store" && operation != "get" && operation != "erase" && operation != "list" {
+		return fmt.Errorf("unknown operation %q, use store, get, erase or list", operation)
+	}
+
+	cryptoService, err := masterKeyStore.GetCryptoService()
+	if err != nil {
+		return errors.New("secrets are locked, run 'gophkeeper unlock' first")
+	}
+
+	entries, err := listDockerCredentials(cryptoService)
+	if err != nil {
+		return err
+	}
+
+	switch operation {
+	case "store":
+		var credential dockerCredential
+		if err := json.NewDecoder(in).Decode(&credential); err != nil {
+			return fmt.Errorf("invalid credentials: %w", err)
+		}
+		if credential.ServerURL == "" {
+			return errors.New("missing server URL")
+		}
+		return storeDockerCredential(cryptoService, entries, &credential)
+	case "get":
+		serverURL, err := readServerURL(in)
+		if err != nil {
+			return err
+		}
+		entry, ok := findDockerCredential(entries, serverURL)
+		if !ok {
+			return errDockerCredentialsNotFound
+		}
+		return json.NewEncoder(out).Encode(dockerCredential{
+			ServerURL: serverURL,
+			Username:  entry.credential.Username,
+			Secret:    entry.credential.Password,
+		})
+	case "erase":
+		serverURL, err := readServerURL(in)
+		if err != nil {
+			return err
+		}
+		entry, ok := findDockerCredential(entries, serverURL)
+		if !ok {
+			return errDockerCredentialsNotFound
+		}
+		if err := resourceClient.DeleteResource(entry.resource.GetId(), entry.resource.GetRevision()); err != nil {
+			return fmt.Errorf("failed to delete credentials: %w", err)
+		}
+		return nil
+	}
+
+	registries := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		registries[entry.credential.URLs[0]] = entry.credential.Username
+	}
+	return json.NewEncoder(out).Encode(registries)
+}
+
+// listDockerCredentials decrypts the credentials stored in the docker folder
+// Credentials without a URL are not registry credentials and are skipped
+func listDockerCredentials(cryptoService *crypto.CryptoService) ([]dockerEntry, error) {
+	resources, err := resourceClient.ListAllResources(&pb.ListResourcesRequest{Type: proto.String(string(models.TypeCredentials))})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+	revealNames(cryptoService, resources)
+
+	var entries []dockerEntry
+	for _, r := range resources {
+		if !models.IsInFolder(r.GetName(), dockerFolder) {
+			continue
+		}
+		resource, err := resourceClient.GetResource(r.GetId())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get '%s': %w", r.GetName(), err)
+		}
+		credential, err := decryptCredential(cryptoService, resource.GetData())
+		if err != nil || len(credential.URLs) == 0 {
+			continue
+		}
+		resource.Name = proto.String(r.GetName())
+		entries = append(entries, dockerEntry{resource: resource, credential: credential})
+	}
+	return entries, nil
+}
+
+// findDockerCredential returns the credentials of a registry
+func findDockerCredential(entries []dockerEntry, serverURL string) (dockerEntry, bool) {
+	key := registryKey(serverURL)
+	for _, entry := range entries {
+		if registryKey(entry.credential.URLs[0]) == key {
+			return entry, true
+		}
+	}
+	return dockerEntry{}, false
+}
+
+// storeDockerCredential creates the credentials of a registry or replaces the stored ones
+func storeDockerCredential(cryptoService *crypto.CryptoService, entries []dockerEntry, credential *dockerCredential) error {
+	if entry, ok := findDockerCredential(entries, credential.ServerURL); ok {
+		if entry.credential.Username == credential.Username && entry.credential.Password == credential.Secret {
+			return nil
+		}
+		rotated := entry.credential.Password != credential.Secret
+		entry.credential.Username = credential.Username
+		entry.credential.ChangePassword(credential.Secret, time.Now())
+		return saveCredential(cryptoService, entry.resource, entry.credential, rotated)
+	}
+
+	plaintext, err := json.Marshal(&models.Credential{
+		Username: credential.Username,
+		Password: credential.Secret,
+		URLs:     []string{credential.ServerURL},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode credentials: %w", err)
+	}
+
+	name := dockerFolder + models.PathSeparator + registryKey(credential.ServerURL)
+	_, err = createSecret(cryptoService, name, string(models.TypeCredentials), plaintext, &models.ResourceMetadata{}, nil)
+	return err
+}
+
+// readServerURL reads the server URL sent by Docker for get and erase
+func readServerURL(in io.Reader) (string, error) {
+	data, err := io.ReadAll(in)
+	if err != nil {
+		return "", err
+	}
+	serverURL := strings.TrimSpace(string(data))
+	if serverURL == "" {
+		return "", errors.New("missing server URL")
+	}
+	return serverURL, nil
+}
+
+// registryKey normalizes a registry server URL: no scheme, lowercase host, no trailing slash
+// https://index.docker.io/v1/ and index.docker.io/v1 are the same registry
+func registryKey(serverURL string) string {
+	if _, rest, ok := strings.Cut(serverURL, "://"); ok {
+		serverURL = rest
+	}
+	serverURL = strings.Trim(serverURL, "/")
+	host, path, _ := strings.Cut(serverURL, "/")
+	if path == "" {
+		return strings.ToLower(host)
+	}
+	return strings.ToLower(host) + "/" + path
+}
+
+// ExecuteDockerCredentialHelper runs the docker-credential command with the process arguments,
+// it is the entry point of the docker-credential-gophkeeper binary
+func ExecuteDockerCredentialHelper() {
+	rootCmd.SetArgs(append([]string{dockerCredentialCmd.Name()}, os.Args[1:]...))
+	Execute()
+}
+
+func init() {
+	rootCmd.AddCommand(dockerCredentialCmd)
+}
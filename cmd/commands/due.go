@@ -0,0 +1,115 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// dueEntry is a secret as printed by the json output of the due command
+type dueEntry struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	DueAt      string `json:"due_at"`
+	Reason     string `json:"reason"` // "expires" or "rotation"
+	ExpiresAt  string `json:"expires_at,omitempty"`
+	RotatedAt  string `json:"rotated_at,omitempty"`
+	RotateDays int64  `json:"rotate_every_days,omitempty"`
+}
+
+// dueCmd represents the due command
+var dueCmd = &cobra.Command{
+	Use:   "due",
+	Short: "List secrets that are expired or due for rotation",
+	Long: `List secrets whose expiry date (--expires) or next rotation (--rotate-every)
+is reached within the given period, the earliest due first.
+
+Updating the value of a secret restarts its rotation interval.
+
+Examples:
+  gophkeeper due
+  gophkeeper due --within 30d
+  gophkeeper due --within 0 -o json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		within, _ := cmd.Flags().GetString("within")
+		output, _ := cmd.Flags().GetString("output")
+
+		if output != "table" && output != "json" {
+			fmt.Println("✗ Invalid output format. Use: table or json")
+			return
+		}
+
+		period, err := parseInterval(within)
+		if err != nil {
+			fmt.Printf("✗ %v\n", err)
+			return
+		}
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		now := time.Now()
+		resources, err := resourceClient.ListExpiring(now.Add(period))
+		if err != nil {
+			fmt.Printf("✗ Failed to list due secrets: %v\n", err)
+			return
+		}
+		revealNames(cryptoService, resources)
+
+		if output == "json" {
+			entries := make([]dueEntry, len(resources))
+			for i, r := range resources {
+				entries[i] = dueEntry{
+					ID:         r.GetId(),
+					Name:       r.GetName(),
+					Type:       r.GetType(),
+					DueAt:      r.GetDueAt(),
+					Reason:     dueReason(r),
+					ExpiresAt:  r.GetExpiresAt(),
+					RotatedAt:  r.GetRotatedAt(),
+					RotateDays: r.GetRotateEvery() / (24 * 60 * 60),
+				}
+			}
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(entries); err != nil {
+				fmt.Fprintf(os.Stderr, "✗ Failed to encode JSON: %v\n", err)
+			}
+			return
+		}
+
+		if len(resources) == 0 {
+			fmt.Println("✓ No secrets are due")
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tTYPE\tDUE\tREASON\tSTATUS")
+		for _, r := range resources {
+			reason := dueReason(r)
+			if reason == "rotation" {
+				reason = fmt.Sprintf("rotation every %s, last %s", formatInterval(r.GetRotateEvery()), formatTimestamp(r.GetRotatedAt()))
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.GetName(), r.GetType(), formatTimestamp(r.GetDueAt()), reason, dueMark(r, now))
+		}
+		w.Flush()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(dueCmd)
+	dueCmd.Flags().String("within", "14d", "Also list secrets due within this period, e.g. 30d or 2w, 0 for overdue only")
+	dueCmd.Flags().StringP("output", "o", "table", "Output format: table | json")
+}
@@ -0,0 +1,156 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+// dueSoon is how long before the due date a secret is marked in list output
+const dueSoon = 14 * 24 * time.Hour
+
+// expirySettings are the expiry date and rotation interval given with --expires and --rotate-every
+type expirySettings struct {
+	ExpiresAt      *time.Time    // nil if the secret does not expire
+	RotateEvery    time.Duration // 0 if the secret has no rotation interval
+	expiresChanged bool
+	rotateChanged  bool
+}
+
+// addExpiryFlags registers flags for the expiry date and rotation interval of a secret
+func addExpiryFlags(cmd *cobra.Command) {
+	cmd.Flags().String("expires", "", "Expiry date: YYYY-MM-DD or RFC 3339 time, 'never' removes it")
+	cmd.Flags().String("rotate-every", "", "Rotation interval, e.g. 90d, 12w or 720h, 0 removes it")
+}
+
+// readExpiry parses the expiry flags, unset flags keep the current values on update
+func readExpiry(cmd *cobra.Command) (*expirySettings, error) {
+	settings := &expirySettings{
+		expiresChanged: cmd.Flags().Changed("expires"),
+		rotateChanged:  cmd.Flags().Changed("rotate-every"),
+	}
+
+	if settings.expiresChanged {
+		value, _ := cmd.Flags().GetString("expires")
+		expiresAt, err := parseExpiryDate(value)
+		if err != nil {
+			return nil, err
+		}
+		settings.ExpiresAt = expiresAt
+	}
+
+	if settings.rotateChanged {
+		value, _ := cmd.Flags().GetString("rotate-every")
+		interval, err := parseInterval(value)
+		if err != nil {
+			return nil, err
+		}
+		settings.RotateEvery = interval
+	}
+
+	return settings, nil
+}
+
+// changed reports whether any expiry flag was given
+func (s *expirySettings) changed() bool {
+	return s.expiresChanged || s.rotateChanged
+}
+
+// apply adds the changed settings to a partial update
+func (s *expirySettings) apply(req *pb.UpdateResourceRequest) {
+	if s.expiresChanged {
+		req.ExpiresAt = proto.String("")
+		if s.ExpiresAt != nil {
+			req.ExpiresAt = proto.String(s.ExpiresAt.UTC().Format(time.RFC3339))
+		}
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "expires_at")
+	}
+	if s.rotateChanged {
+		req.RotateEvery = proto.Int64(int64(s.RotateEvery / time.Second))
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "rotate_every")
+	}
+}
+
+// parseExpiryDate parses a date (the secret expires at its local midnight) or an RFC 3339 time
+// Returns nil for "never" or an empty value
+func parseExpiryDate(value string) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.EqualFold(value, "never") {
+		return nil, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expiry date %q, expected YYYY-MM-DD or RFC 3339 time", value)
+	}
+	return &t, nil
+}
+
+// parseInterval parses a rotation interval in days (90d), weeks (12w) or as a Go duration (720h)
+// "0" or an empty value means no rotation
+func parseInterval(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "0" {
+		return 0, nil
+	}
+
+	units := map[byte]time.Duration{'d': 24 * time.Hour, 'w': 7 * 24 * time.Hour}
+	if unit, ok := units[value[len(value)-1]]; ok {
+		n, err := strconv.Atoi(value[:len(value)-1])
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid rotation interval %q, expected e.g. 90d, 12w or 720h", value)
+		}
+		return time.Duration(n) * unit, nil
+	}
+
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval < 0 {
+		return 0, fmt.Errorf("invalid rotation interval %q, expected e.g. 90d, 12w or 720h", value)
+	}
+	return interval, nil
+}
+
+// formatInterval formats a rotation interval in seconds as whole days or weeks where possible
+func formatInterval(seconds int64) string {
+	interval := time.Duration(seconds) * time.Second
+	switch {
+	case interval%(7*24*time.Hour) == 0:
+		return fmt.Sprintf("%dw", interval/(7*24*time.Hour))
+	case interval%(24*time.Hour) == 0:
+		return fmt.Sprintf("%dd", interval/(24*time.Hour))
+	}
+	return interval.String()
+}
+
+// dueReason tells why a resource is due: its expiry date or its rotation interval, whichever comes first
+func dueReason(r *pb.GetResourceResponse) string {
+	if r.GetExpiresAt() != "" && r.GetExpiresAt() == r.GetDueAt() {
+		return "expires"
+	}
+	return "rotation"
+}
+
+// dueMark returns a warning for a resource that is overdue or due within dueSoon, empty otherwise
+func dueMark(r *pb.GetResourceResponse, now time.Time) string {
+	dueAt, err := time.Parse(time.RFC3339, r.GetDueAt())
+	if err != nil {
+		return ""
+	}
+	switch {
+	case !dueAt.After(now):
+		return "⚠ overdue"
+	case dueAt.Sub(now) <= dueSoon:
+		return "⚠ due soon"
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+)
+
+// readFile reads a file together with its name, permissions and modification time
+func readFile(path string) ([]byte, *models.FileInfo, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, nil, fmt.Errorf("%s is not a regular file", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, &models.FileInfo{
+		Name:    info.Name(),
+		Mode:    info.Mode().Perm(),
+		ModTime: info.ModTime().UTC(),
+	}, nil
+}
+
+// writeFile writes data to path restoring the permissions and modification time from info
+// Files without info are written readable by the owner only.
+// An existing file is overwritten only if force is set.
+//
+// Data is written to a 0600 temporary file next to path which then replaces it,
+// so the file is never readable with wider permissions while being written
+// and a failed write leaves an existing file untouched.
+func writeFile(path string, data []byte, info *models.FileInfo, force bool) error {
+	mode := os.FileMode(0600)
+	if info != nil && info.Mode != 0 {
+		mode = info.Mode.Perm()
+	}
+
+	if !force {
+		if _, err := os.Lstat(path); err == nil {
+			return fmt.Errorf("%s already exists, use --force to overwrite it", path)
+		}
+	}
+
+	// CreateTemp creates the file with 0600 permissions
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := file.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Chmod(mode); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if info != nil && !info.ModTime.IsZero() {
+		if err := os.Chtimes(tmpPath, info.ModTime, info.ModTime); err != nil {
+			return err
+		}
+	}
+
+	if force {
+		return os.Rename(tmpPath, path)
+	}
+	// Link fails if path was created meanwhile, unlike Rename which would replace it
+	if err := os.Link(tmpPath, path); err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("%s already exists, use --force to overwrite it", path)
+		}
+		return err
+	}
+	return nil
+}
+
+// restorePath returns the path in the current directory to restore a file to under its original name
+func restorePath(info *models.FileInfo) (string, error) {
+	if info == nil {
+		return "", errors.New("the original file name is unknown, use --out instead")
+	}
+
+	// The name comes from decrypted metadata, never let it point outside the current directory
+	name := filepath.Base(info.Name)
+	if name == "." || name == ".." || name == string(filepath.Separator) {
+		return "", fmt.Errorf("invalid original file name %q, use --out instead", info.Name)
+	}
+	return name, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/spf13/cobra"
+)
+
+// generateCmd represents the generate command
+var generateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generate a random password or passphrase",
+	Long: `Generate a random password or a diceware passphrase using a cryptographically secure random source.
+The result is printed to stdout and its strength to stderr, so it can be piped or captured safely.
+
+Use 'gophkeeper set -t credentials --generate' to store a generated password without printing it.
+
+Examples:
+  gophkeeper generate
+  gophkeeper generate --length 32 --no-symbols
+  gophkeeper generate --exclude-ambiguous --exclude '{}[]'
+  gophkeeper generate --pronounceable --length 12
+  gophkeeper generate --passphrase --words 6 --separator - --capitalize
+  gophkeeper generate --count 5`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		count, _ := cmd.Flags().GetInt("count")
+		if count < 1 {
+			fmt.Println("✗ Count must be at least 1")
+			return
+		}
+
+		for range count {
+			password, entropy, err := generatePassword(cmd)
+			if err != nil {
+				fmt.Printf("✗ %v\n", err)
+				return
+			}
+			fmt.Println(password)
+			if count == 1 {
+				fmt.Fprintf(os.Stderr, "Strength: %.0f bits\n", entropy)
+			}
+		}
+	},
+}
+
+// addGeneratorFlags registers the password policy flags
+func addGeneratorFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("length", crypto.DefaultPasswordLength, "Length of the generated password")
+	cmd.Flags().Bool("no-lower", false, "Do not use lower case letters in the generated password")
+	cmd.Flags().Bool("no-upper", false, "Do not use upper case letters in the generated password")
+	cmd.Flags().Bool("no-digits", false, "Do not use digits in the generated password")
+	cmd.Flags().Bool("no-symbols", false, "Do not use symbols in the generated password")
+	cmd.Flags().Bool("exclude-ambiguous", false, "Do not use characters that are easily confused, e.g. l, 1, O and 0")
+	cmd.Flags().String("exclude", "", "Characters never used in the generated password")
+	cmd.Flags().Bool("pronounceable", false, "Generate a password of alternating consonants and vowels")
+	cmd.Flags().Bool("passphrase", false, "Generate a diceware passphrase instead of a password")
+	cmd.Flags().Int("words", crypto.DefaultPassphraseWords, "Number of words of the passphrase")
+	cmd.Flags().String("separator", " ", "Separator between the words of the passphrase")
+	cmd.Flags().Bool("capitalize", false, "Capitalize the words of the passphrase")
+}
+
+// generatePassword generates a password or passphrase according to the generator flags
+// Returns the password and its entropy in bits
+func generatePassword(cmd *cobra.Command) (string, float64, error) {
+	if passphrase, _ := cmd.Flags().GetBool("passphrase"); passphrase {
+		words, _ := cmd.Flags().GetInt("words")
+		separator, _ := cmd.Flags().GetString("separator")
+		capitalize, _ := cmd.Flags().GetBool("capitalize")
+
+		password, err := crypto.GeneratePassphrase(words, separator, capitalize)
+		return password, crypto.PassphraseEntropy(words), err
+	}
+
+	policy := crypto.DefaultPasswordPolicy()
+	policy.Length, _ = cmd.Flags().GetInt("length")
+	policy.ExcludeAmbiguous, _ = cmd.Flags().GetBool("exclude-ambiguous")
+	policy.Exclude, _ = cmd.Flags().GetString("exclude")
+	for _, class := range []struct {
+		flag    string
+		enabled *bool
+	}{{"no-lower", &policy.Lower}, {"no-upper", &policy.Upper}, {"no-digits", &policy.Digits}, {"no-symbols", &policy.Symbols}} {
+		disabled, _ := cmd.Flags().GetBool(class.flag)
+		*class.enabled = !disabled
+	}
+
+	if pronounceable, _ := cmd.Flags().GetBool("pronounceable"); pronounceable {
+		password, err := crypto.GeneratePronounceable(policy)
+		return password, crypto.PronounceableEntropy(policy), err
+	}
+
+	password, err := crypto.GeneratePassword(policy)
+	return password, crypto.PasswordEntropy(policy), err
+}
+
+func init() {
+	rootCmd.AddCommand(generateCmd)
+	addGeneratorFlags(generateCmd)
+	generateCmd.Flags().IntP("count", "c", 1, "Number of passwords to generate")
+}
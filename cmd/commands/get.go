@@ -5,7 +5,14 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
 	"github.com/spf13/cobra"
 )
 
@@ -13,8 +20,33 @@ import (
 var getCmd = &cobra.Command{
 	Use:   "get",
 	Short: "Get encrypted data from the storage",
-	Long:  `gophkeeperk get <name>`,
-	Args:  cobra.ExactArgs(1),
+	Long: `gophkeeper get <name> [--field <field>]
+
+With --field only the value is printed, a missing field exits with status 1
+so scripts such as export TOKEN=$(gophkeeper get api --field token) fail loudly.
+
+Examples:
+  gophkeeper get github
+  gophkeeper get github --field password
+
+  # Previous passwords are kept encrypted inside the credential
+  gophkeeper get github --password-history
+
+  # Card numbers, CVV and PIN are masked unless --reveal is given,
+  # --field always prints the exact value
+  gophkeeper get cards/visa --reveal
+  gophkeeper get cards/visa --field number
+
+  # SSH private keys are hidden unless --reveal is given
+  gophkeeper get keys/github --field public_key >> ~/.ssh/authorized_keys
+
+  # Secret fields of custom types are masked unless --reveal is given
+  gophkeeper get home/wifi --field password
+
+  # Files are written back with their original permissions and modification time
+  gophkeeper get keys/id_rsa --out ~/.ssh/id_rsa
+  gophkeeper get keys/id_rsa --restore --force`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		name := args[0]
 
@@ -24,24 +56,229 @@ var getCmd = &cobra.Command{
 			return
 		}
 
-		response, err := resourceClient.GetResourceByName(name)
+		response, err := findResource(cryptoService, name)
 		if err != nil {
 			fmt.Printf("✗ Failed to get secret: %v\n", err)
 			return
 		}
 
+		field, _ := cmd.Flags().GetString("field")
+		reveal, _ := cmd.Flags().GetBool("reveal")
+		out, _ := cmd.Flags().GetString("out")
+		restore, _ := cmd.Flags().GetBool("restore")
+		history, _ := cmd.Flags().GetBool("password-history")
+
+		if out != "" || restore {
+			if out != "" && restore {
+				fmt.Println("✗ Cannot use both --out and --restore")
+				return
+			}
+			force, _ := cmd.Flags().GetBool("force")
+			saveResource(cryptoService, response, out, force)
+			return
+		}
+
+		if history && response.GetType() != string(models.TypeCredentials) {
+			fmt.Println("✗ --password-history is only supported for credentials")
+			return
+		}
+
+		if response.GetType() == string(models.TypeCredentials) {
+			if credential, err := decryptCredential(cryptoService, response.GetData()); err == nil {
+				if field != "" {
+					value, ok := credential.Field(field)
+					if !ok {
+						fmt.Fprintf(os.Stderr, "✗ Field '%s' not found\n", field)
+						os.Exit(1)
+					}
+					fmt.Println(value)
+					return
+				}
+
+				if history {
+					printPasswordHistory(credential)
+					return
+				}
+
+				printResourceHeader(cryptoService, response)
+				printCredential(credential)
+				return
+			}
+		}
+
+		if response.GetType() == string(models.TypeCard) {
+			if card, err := decryptCard(cryptoService, response.GetData()); err == nil {
+				warnIfExpired(card)
+
+				if field != "" {
+					value, ok := card.Field(field)
+					if !ok {
+						fmt.Fprintf(os.Stderr, "✗ Field '%s' not found\n", field)
+						os.Exit(1)
+					}
+					fmt.Println(value)
+					return
+				}
+
+				printResourceHeader(cryptoService, response)
+				printCard(card, reveal)
+				return
+			}
+		}
+
+		if response.GetType() == string(models.TypeTOTP) {
+			if totp, err := decryptTOTP(cryptoService, response.GetData()); err == nil {
+				if field != "" {
+					value, ok := totp.Field(field)
+					if !ok {
+						fmt.Fprintf(os.Stderr, "✗ Field '%s' not found\n", field)
+						os.Exit(1)
+					}
+					fmt.Println(value)
+					return
+				}
+
+				printResourceHeader(cryptoService, response)
+				printTOTP(totp, reveal)
+				return
+			}
+		}
+
+		if response.GetType() == string(models.TypeSSHKey) {
+			if key, err := decryptSSHKey(cryptoService, response.GetData()); err == nil {
+				if field != "" {
+					value, ok := key.Field(field)
+					if !ok {
+						fmt.Fprintf(os.Stderr, "✗ Field '%s' not found\n", field)
+						os.Exit(1)
+					}
+					fmt.Println(strings.TrimRight(value, "\n"))
+					return
+				}
+
+				printResourceHeader(cryptoService, response)
+				printSSHKey(key, reveal)
+				return
+			}
+		}
+
+		if !models.IsBuiltInType(models.ResourceType(response.GetType())) {
+			definition, err := lookupType(response.GetType())
+			if err != nil {
+				fmt.Printf("✗ %v\n", err)
+				return
+			}
+			record, err := decryptRecord(cryptoService, response.GetData())
+			if err != nil {
+				fmt.Printf("✗ Decryption failed: %v\n", err)
+				return
+			}
+
+			if field != "" {
+				f, ok := definition.Field(field)
+				if !ok {
+					fmt.Fprintf(os.Stderr, "✗ Field '%s' not found\n", field)
+					os.Exit(1)
+				}
+				fmt.Println(record.Fields[f.Name])
+				return
+			}
+
+			printResourceHeader(cryptoService, response)
+			printRecord(definition, record, reveal)
+			return
+		}
+
 		decryptedData, err := cryptoService.DecryptData(response.GetData())
 		if err != nil {
 			fmt.Printf("✗ Decryption failed: %v\n", err)
 			return
 		}
 
-		fmt.Printf("Name: %s\n", response.GetName())
-		fmt.Printf("Type: %s\n", response.GetType())
+		if field != "" {
+			if field != "value" {
+				fmt.Fprintf(os.Stderr, "✗ Field '%s' not found, this secret only has a value\n", field)
+				os.Exit(1)
+			}
+			fmt.Println(string(decryptedData))
+			return
+		}
+
+		printResourceHeader(cryptoService, response)
+		if !utf8.Valid(decryptedData) {
+			fmt.Printf("Value: <%s of binary data, use --out or --restore to save it>\n", formatSize(int64(len(decryptedData))))
+			return
+		}
 		fmt.Printf("Value: %s\n", string(decryptedData))
 	},
 }
 
+// saveResource decrypts the value of a secret and writes it to a file
+// An empty path restores the file under its original name in the current directory
+func saveResource(cryptoService *crypto.CryptoService, response *pb.GetResourceResponse, path string, force bool) {
+	data, err := cryptoService.DecryptData(response.GetData())
+	if err != nil {
+		fmt.Printf("✗ Decryption failed: %v\n", err)
+		return
+	}
+
+	// SSH keys are saved as the private key file
+	if response.GetType() == string(models.TypeSSHKey) {
+		if key, err := decryptSSHKey(cryptoService, response.GetData()); err == nil {
+			data = []byte(key.PrivateKey)
+		}
+	}
+
+	metadata, err := decryptMetadata(cryptoService, response.GetMetadata())
+	if err != nil {
+		fmt.Printf("✗ Failed to decrypt metadata: %v\n", err)
+		return
+	}
+
+	if path == "" {
+		path, err = restorePath(metadata.File)
+		if err != nil {
+			fmt.Printf("✗ %v\n", err)
+			return
+		}
+	}
+
+	if err := writeFile(path, data, metadata.File, force); err != nil {
+		fmt.Printf("✗ Failed to write file: %v\n", err)
+		return
+	}
+	fmt.Printf("✓ Saved %s to '%s'\n", formatSize(int64(len(data))), path)
+}
+
+// printResourceHeader prints the name, type, revision, expiry and decrypted metadata of a secret
+func printResourceHeader(cryptoService *crypto.CryptoService, response *pb.GetResourceResponse) {
+	fmt.Printf("Name: %s\n", displayName(cryptoService, response))
+	fmt.Printf("Type: %s\n", response.GetType())
+	fmt.Printf("Revision: %d\n", response.GetRevision())
+	if response.GetExpiresAt() != "" {
+		fmt.Printf("Expires: %s\n", formatTimestamp(response.GetExpiresAt()))
+	}
+	if response.GetRotateEvery() > 0 {
+		fmt.Printf("Rotate every: %s (last %s)\n", formatInterval(response.GetRotateEvery()), formatTimestamp(response.GetRotatedAt()))
+	}
+	if mark := dueMark(response, time.Now()); mark != "" {
+		fmt.Printf("%s: %s\n", mark, formatTimestamp(response.GetDueAt()))
+	}
+
+	metadata, err := decryptMetadata(cryptoService, response.GetMetadata())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "✗ Failed to decrypt metadata: %v\n", err)
+		return
+	}
+	printMetadata(metadata)
+}
+
 func init() {
 	rootCmd.AddCommand(getCmd)
+	getCmd.Flags().String("field", "", "Print only this field, e.g. password, username, url, notes or a custom field; number, expiry, cvv, pin for cards; public_key, private_key, fingerprint for SSH keys; code, secret, uri for totp; any field of a custom type")
+	getCmd.Flags().Bool("password-history", false, "Print the previous passwords of a credential and when they were replaced")
+	getCmd.Flags().Bool("reveal", false, "Show the full card number, CVV and PIN, the SSH private key, the TOTP secret or secret fields of custom types")
+	getCmd.Flags().String("out", "", "Write the value to this file instead of printing it")
+	getCmd.Flags().Bool("restore", false, "Write the value to a file with its original name in the current directory")
+	getCmd.Flags().Bool("force", false, "Overwrite an existing file with --out or --restore")
 }
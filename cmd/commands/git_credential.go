@@ -0,0 +1,330 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+// gitCredentialTag marks credentials created by the helper, only those are ever erased
+const gitCredentialTag = "git-credential"
+
+// gitCredential is a credential description exchanged with git, see git-credential(1)
+type gitCredential struct {
+	Protocol string
+	Host     string // may include the port
+	Path     string // sent only if credential.useHttpPath is set
+	Username string
+	Password string
+}
+
+// gitMatch is a stored credential matching a git request
+type gitMatch struct {
+	resource   *pb.GetResourceResponse
+	credential *models.Credential
+	score      int  // higher is more specific
+	hostFolder bool // stored under git/<host>
+}
+
+// gitCredentialCmd represents the git-credential command
+var gitCredentialCmd = &cobra.Command{
+	Use:   "git-credential <get|store|erase>",
+	Short: "Git credential helper backed by the vault",
+	Long: `Git credential helper backed by the vault.
+
+Git asks the helper for credentials of a protocol, host and optionally path.
+They are looked up among credentials by their URLs: the scheme and host must match,
+a URL with a path matches only requests for that path or below it, the most specific
+URL wins. A requested username must match too. Credentials stored under git/<host>
+are checked first, the others only if none of them matches.
+
+store saves new credentials as git/<host>[/<path>] tagged git-credential and changes
+the password of an existing one, keeping the old password in its history. erase moves
+to trash only credentials store created, and only if their password is the one git
+rejected. Credentials created by hand are never erased.
+
+Set it up once, the vault must be unlocked for git to get credentials:
+  git config --global credential.helper '!gophkeeper git-credential'
+
+  # Send paths to tell apart several accounts on one host
+  git config --global credential.https://github.com.useHttpPath true`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		request, err := readGitCredential(os.Stdin)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "✗ %v\n", err)
+			os.Exit(1)
+		}
+
+		// Unknown operations must be ignored for forward compatibility
+		operation := args[0]
+		if operation != "get" && operation != "store" && operation != "erase" {
+			return
+		}
+		if request.Host == "" {
+			return
+		}
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			// Git falls back to other helpers or prompts
+			fmt.Fprintln(os.Stderr, "✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		matches, err := findGitCredentials(cryptoService, request)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "✗ %v\n", err)
+			os.Exit(1)
+		}
+
+		switch operation {
+		case "get":
+			if len(matches) == 0 {
+				return
+			}
+			credential := matches[0].credential
+			writeGitCredential(os.Stdout, &gitCredential{Username: credential.Username, Password: credential.Password})
+		case "store":
+			err = storeGitCredential(cryptoService, request, matches)
+		case "erase":
+			err = eraseGitCredential(cryptoService, request, matches)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "✗ %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// readGitCredential reads key=value lines until an empty line or the end of input
+// A url attribute is split into protocol, host and path
+func readGitCredential(r io.Reader) (*gitCredential, error) {
+	credential := &gitCredential{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid credential line %q", line)
+		}
+
+		switch key {
+		case "protocol":
+			credential.Protocol = value
+		case "host":
+			credential.Host = value
+		case "path":
+			credential.Path = value
+		case "username":
+			credential.Username = value
+		case "password":
+			credential.Password = value
+		case "url":
+			u, err := url.Parse(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid url %q", value)
+			}
+			credential.Protocol, credential.Host, credential.Path = u.Scheme, u.Host, strings.TrimPrefix(u.Path, "/")
+			if u.User != nil {
+				credential.Username = u.User.Username()
+			}
+		}
+	}
+	return credential, scanner.Err()
+}
+
+// writeGitCredential writes the username and password for git
+// Values containing newlines cannot be expressed in the protocol and are skipped
+func writeGitCredential(w io.Writer, credential *gitCredential) {
+	if credential.Username != "" && !strings.ContainsAny(credential.Username, "\n\x00") {
+		fmt.Fprintf(w, "username=%s\n", credential.Username)
+	}
+	if credential.Password != "" && !strings.ContainsAny(credential.Password, "\n\x00") {
+		fmt.Fprintf(w, "password=%s\n", credential.Password)
+	}
+}
+
+// findGitCredentials returns the credentials matching the request, the best match first
+// Only credentials under git/<host> are fetched and decrypted when one of them matches,
+// all the others are checked only otherwise
+func findGitCredentials(cryptoService *crypto.CryptoService, request *gitCredential) ([]gitMatch, error) {
+	resources, err := resourceClient.ListAllResources(&pb.ListResourcesRequest{Type: proto.String(string(models.TypeCredentials))})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+	revealNames(cryptoService, resources)
+	sort.Slice(resources, func(i, j int) bool { return resources[i].GetName() < resources[j].GetName() })
+
+	folder := strings.ToLower("git" + models.PathSeparator + request.Host)
+	var hostResources, otherResources []*pb.GetResourceResponse
+	for _, r := range resources {
+		name := strings.ToLower(r.GetName())
+		if name == folder || models.IsInFolder(name, folder) {
+			hostResources = append(hostResources, r)
+		} else {
+			otherResources = append(otherResources, r)
+		}
+	}
+
+	matches, err := matchGitCredentials(cryptoService, hostResources, request)
+	if err != nil || len(matches) > 0 {
+		for i := range matches {
+			matches[i].hostFolder = true
+		}
+		return matches, err
+	}
+	return matchGitCredentials(cryptoService, otherResources, request)
+}
+
+// matchGitCredentials fetches and decrypts credentials and returns those matching the request, the best match first
+func matchGitCredentials(cryptoService *crypto.CryptoService, resources []*pb.GetResourceResponse, request *gitCredential) ([]gitMatch, error) {
+	var matches []gitMatch
+	for _, r := range resources {
+		resource, err := resourceClient.GetResource(r.GetId())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get '%s': %w", r.GetName(), err)
+		}
+		credential, err := decryptCredential(cryptoService, resource.GetData())
+		if err != nil {
+			continue
+		}
+		if request.Username != "" && credential.Username != request.Username {
+			continue
+		}
+
+		best := -1
+		for _, u := range credential.URLs {
+			if score, ok := matchGitURL(u, request); ok && score > best {
+				best = score
+			}
+		}
+		if best >= 0 {
+			resource.Name = proto.String(r.GetName())
+			matches = append(matches, gitMatch{resource: resource, credential: credential, score: best})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+	return matches, nil
+}
+
+// matchGitURL checks if a stored URL covers the request and returns how specific it is
+// URLs without a scheme (github.com/org) match any protocol
+func matchGitURL(rawURL string, request *gitCredential) (int, bool) {
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "//" + rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || !strings.EqualFold(u.Host, request.Host) {
+		return 0, false
+	}
+
+	score := 0
+	if u.Scheme != "" {
+		if !strings.EqualFold(u.Scheme, request.Protocol) {
+			return 0, false
+		}
+		score++
+	}
+
+	storedPath := trimGitPath(u.Path)
+	requestPath := trimGitPath(request.Path)
+	if storedPath == "" || requestPath == "" {
+		// Without useHttpPath git sends no path, any URL of the host matches
+		return score, true
+	}
+	if requestPath != storedPath && !strings.HasPrefix(requestPath, storedPath+"/") {
+		return 0, false
+	}
+	return score + 1 + strings.Count(storedPath, "/"), true
+}
+
+// trimGitPath removes surrounding slashes and the .git suffix of a repository path
+func trimGitPath(path string) string {
+	return strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+}
+
+// storeGitCredential saves credentials git has successfully used
+func storeGitCredential(cryptoService *crypto.CryptoService, request *gitCredential, matches []gitMatch) error {
+	if request.Username == "" || request.Password == "" {
+		return nil
+	}
+
+	// Matches always have the requested username
+	if len(matches) > 0 {
+		match := matches[0]
+		if match.credential.Password == request.Password {
+			return nil
+		}
+		match.credential.ChangePassword(request.Password, time.Now())
+		return saveCredential(cryptoService, match.resource, match.credential, true)
+	}
+
+	credentialURL := url.URL{Scheme: request.Protocol, Host: request.Host, Path: "/" + trimGitPath(request.Path)}
+	credential := &models.Credential{
+		Username: request.Username,
+		Password: request.Password,
+		URLs:     []string{strings.TrimSuffix(credentialURL.String(), "/")},
+	}
+	plaintext, err := json.Marshal(credential)
+	if err != nil {
+		return fmt.Errorf("failed to encode credential: %w", err)
+	}
+
+	metadata := &models.ResourceMetadata{}
+	metadata.AddTag(gitCredentialTag)
+
+	name := strings.TrimSuffix("git/"+request.Host+"/"+trimGitPath(request.Path), "/")
+	_, err = createSecret(cryptoService, name, string(models.TypeCredentials), plaintext, metadata, nil)
+	if errors.Is(err, errSecretExists) {
+		// Another account on the same host
+		_, err = createSecret(cryptoService, name+"/"+request.Username, string(models.TypeCredentials), plaintext, metadata, nil)
+	}
+	return err
+}
+
+// eraseGitCredential moves to trash the credentials git rejected
+// Only credentials store created under git/<host> with the rejected password are erased,
+// so a stale request never removes a newer password and credentials created by hand are kept
+func eraseGitCredential(cryptoService *crypto.CryptoService, request *gitCredential, matches []gitMatch) error {
+	if request.Password == "" {
+		return nil
+	}
+	for _, match := range matches {
+		if !match.hostFolder || match.credential.Password != request.Password {
+			continue
+		}
+		metadata, err := decryptMetadata(cryptoService, match.resource.GetMetadata())
+		if err != nil || !metadata.HasTag(gitCredentialTag) {
+			continue
+		}
+		if err := resourceClient.DeleteResource(match.resource.GetId(), match.resource.GetRevision()); err != nil {
+			return fmt.Errorf("failed to delete '%s': %w", match.resource.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(gitCredentialCmd)
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadGitCredential(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    gitCredential
+		wantErr bool
+	}{
+		{
+			name:  "attributes",
+			input: "protocol=https\nhost=github.com\npath=org/repo.git\nusername=octocat\npassword=secret\n",
+			want:  gitCredential{Protocol: "https", Host: "github.com", Path: "org/repo.git", Username: "octocat", Password: "secret"},
+		},
+		{
+			name:  "stops at empty line",
+			input: "protocol=https\nhost=github.com\n\nusername=ignored\n",
+			want:  gitCredential{Protocol: "https", Host: "github.com"},
+		},
+		{
+			name:  "url is split",
+			input: "url=https://octocat@git.example.com:8443/org/repo.git\n",
+			want:  gitCredential{Protocol: "https", Host: "git.example.com:8443", Path: "org/repo.git", Username: "octocat"},
+		},
+		{
+			name:  "value with equals sign",
+			input: "password=a=b\n",
+			want:  gitCredential{Password: "a=b"},
+		},
+		{
+			name:  "unknown attributes ignored",
+			input: "host=github.com\ncapability[]=authtype\n",
+			want:  gitCredential{Host: "github.com"},
+		},
+		{name: "empty input", input: "", want: gitCredential{}},
+		{name: "line without equals sign", input: "host github.com\n", wantErr: true},
+		{name: "invalid url", input: "url=https://[::1\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readGitCredential(strings.NewReader(tt.input))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("readGitCredential() = %+v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readGitCredential() error = %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("readGitCredential() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchGitURL(t *testing.T) {
+	request := &gitCredential{Protocol: "https", Host: "github.com", Path: "org/repo.git"}
+	withoutPath := &gitCredential{Protocol: "https", Host: "github.com"}
+
+	tests := []struct {
+		name      string
+		url       string
+		request   *gitCredential
+		wantScore int
+		wantOK    bool
+	}{
+		{name: "host without scheme", url: "github.com", request: request, wantScore: 0, wantOK: true},
+		{name: "scheme and host", url: "https://github.com", request: request, wantScore: 1, wantOK: true},
+		{name: "host case-insensitive", url: "https://GitHub.com", request: request, wantScore: 1, wantOK: true},
+		{name: "organization", url: "https://github.com/org", request: request, wantScore: 2, wantOK: true},
+		{name: "repository", url: "https://github.com/org/repo", request: request, wantScore: 3, wantOK: true},
+		{name: "repository with .git", url: "https://github.com/org/repo.git/", request: request, wantScore: 3, wantOK: true},
+		{name: "path without scheme", url: "github.com/org", request: request, wantScore: 1, wantOK: true},
+		{name: "no path requested", url: "https://github.com/org/repo", request: withoutPath, wantScore: 1, wantOK: true},
+		{name: "other host", url: "https://gitlab.com", request: request, wantOK: false},
+		{name: "other port", url: "https://github.com:8443", request: request, wantOK: false},
+		{name: "other scheme", url: "ssh://github.com", request: request, wantOK: false},
+		{name: "other organization", url: "https://github.com/other", request: request, wantOK: false},
+		{name: "path prefix is not a folder", url: "https://github.com/or", request: request, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, ok := matchGitURL(tt.url, tt.request)
+			if ok != tt.wantOK {
+				t.Fatalf("matchGitURL(%q) ok = %v, want %v", tt.url, ok, tt.wantOK)
+			}
+			if ok && score != tt.wantScore {
+				t.Errorf("matchGitURL(%q) score = %d, want %d", tt.url, score, tt.wantScore)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package cmd
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"syscall"
@@ -86,24 +87,36 @@ var initCmd = &cobra.Command{
 	},
 }
 
+// stdinReader is shared by prompts so that buffered piped input is not lost between them
+var stdinReader = bufio.NewReader(os.Stdin)
+
 // promptPassword prompts the user for a password without displaying the input
 func promptPassword(prompt string) (string, error) {
 	fmt.Print(prompt)
 
 	password, err := term.ReadPassword(int(syscall.Stdin))
 	if err != nil {
-		reader := bufio.NewReader(os.Stdin)
-		line, err := reader.ReadString('\n')
-		if err != nil {
-			return "", err
-		}
-		return strings.TrimSpace(line), nil
+		return readLine()
 	}
 
 	fmt.Println()
 	return string(password), nil
 }
 
+// promptLine prompts the user for a line of visible input
+func promptLine(prompt string) (string, error) {
+	fmt.Print(prompt)
+	return readLine()
+}
+
+func readLine() (string, error) {
+	line, err := stdinReader.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
 func init() {
 	rootCmd.AddCommand(initCmd)
 }
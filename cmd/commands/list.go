@@ -0,0 +1,302 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// listCmd represents the list command
+var listCmd = &cobra.Command{
+	Use:   "list [folder]",
+	Short: "List stored secrets",
+	Long: `List stored secrets. Values are never fetched or decrypted.
+
+Name patterns use shell-style wildcards: '*' matches any characters except '/',
+'?' matches a single character.
+
+Names and tags are encrypted, they are decrypted and filtered locally.
+
+Examples:
+  gophkeeper list
+  gophkeeper list prod -t credentials
+  gophkeeper list -n 'prod/*/password' --sort name
+  gophkeeper list -o json
+  gophkeeper list --tag prod --tag db
+  gophkeeper list -o names | xargs -n1 gophkeeper get`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		secretType, _ := cmd.Flags().GetString("type")
+		pattern, _ := cmd.Flags().GetString("name")
+		sortBy, _ := cmd.Flags().GetString("sort")
+		desc, _ := cmd.Flags().GetBool("desc")
+		output, _ := cmd.Flags().GetString("output")
+		tags, _ := cmd.Flags().GetStringArray("tag")
+
+		folder := ""
+		if len(args) == 1 {
+			folder = strings.Trim(args[0], models.PathSeparator)
+		}
+
+		if _, err := path.Match(pattern, ""); err != nil {
+			fmt.Printf("✗ Invalid name pattern: %v\n", err)
+			return
+		}
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		var metadata map[int64]*models.ResourceMetadata
+		printers := map[string]func([]*pb.GetResourceResponse, string){
+			"table": func(r []*pb.GetResourceResponse, _ string) { printTable(r, metadata) },
+			"json":  func(r []*pb.GetResourceResponse, _ string) { printJSON(r, metadata) },
+			"yaml":  func(r []*pb.GetResourceResponse, _ string) { printYAML(r, metadata) },
+			"names": printNames,
+			"tree":  printResourceTree,
+		}
+		printer, ok := printers[output]
+		if !ok {
+			fmt.Println("✗ Invalid output format. Use: table, json, yaml, names or tree")
+			return
+		}
+
+		// Names are encrypted, so the server sorts by them meaninglessly and cannot filter by folder or pattern
+		req := &pb.ListResourcesRequest{Type: proto.String(secretType)}
+		if sortBy != "" && sortBy != "name" {
+			req.SortBy = proto.String(sortBy)
+			req.SortDesc = proto.Bool(desc)
+		}
+
+		resources, err := resourceClient.ListAllResources(req)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "✗ Failed to list secrets: %v\n", err)
+			return
+		}
+		revealNames(cryptoService, resources)
+
+		matched := resources[:0]
+		for _, r := range resources {
+			if !models.IsInFolder(r.GetName(), folder) {
+				continue
+			}
+			if ok, _ := path.Match(pattern, r.GetName()); pattern != "" && !ok {
+				continue
+			}
+			matched = append(matched, r)
+		}
+		resources = matched
+
+		if sortBy == "name" {
+			sort.SliceStable(resources, func(i, j int) bool {
+				if desc {
+					return resources[i].GetName() > resources[j].GetName()
+				}
+				return resources[i].GetName() < resources[j].GetName()
+			})
+		}
+
+		metadata = make(map[int64]*models.ResourceMetadata, len(resources))
+		for _, r := range resources {
+			m, err := decryptMetadata(cryptoService, r.GetMetadata())
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "✗ Failed to decrypt metadata of '%s': %v\n", r.GetName(), err)
+				m = &models.ResourceMetadata{}
+			}
+			metadata[r.GetId()] = m
+		}
+
+		// The server only knows padded sizes, order by the real ones
+		if sortBy == "size" {
+			sort.SliceStable(resources, func(i, j int) bool {
+				a, b := realSize(resources[i], metadata[resources[i].GetId()]), realSize(resources[j], metadata[resources[j].GetId()])
+				if desc {
+					return a > b
+				}
+				return a < b
+			})
+		}
+
+		if len(tags) > 0 {
+			matched := resources[:0]
+			for _, r := range resources {
+				if hasAllTags(metadata[r.GetId()], tags) {
+					matched = append(matched, r)
+				}
+			}
+			resources = matched
+		}
+
+		printer(resources, folder)
+	},
+}
+
+// listEntry is a secret as printed by the json and yaml output formats
+type listEntry struct {
+	ID         int64             `json:"id" yaml:"id"`
+	Name       string            `json:"name" yaml:"name"`
+	Type       string            `json:"type" yaml:"type"`
+	Size       int64             `json:"size" yaml:"size"`               // real size, known only to the client
+	StoredSize int64             `json:"stored_size" yaml:"stored_size"` // padded size as stored on the server
+	Revision   int64             `json:"revision" yaml:"revision"`
+	CreatedAt  string            `json:"created_at" yaml:"created_at"`
+	UpdatedAt  string            `json:"updated_at" yaml:"updated_at"`
+	Tags       []string          `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	ExpiresAt  string            `json:"expires_at,omitempty" yaml:"expires_at,omitempty"`
+	RotateDays int64             `json:"rotate_every_days,omitempty" yaml:"rotate_every_days,omitempty"`
+	DueAt      string            `json:"due_at,omitempty" yaml:"due_at,omitempty"` // earlier of the expiry and the next rotation
+}
+
+func toListEntries(resources []*pb.GetResourceResponse, metadata map[int64]*models.ResourceMetadata) []listEntry {
+	entries := make([]listEntry, len(resources))
+	for i, r := range resources {
+		entries[i] = listEntry{
+			ID:         r.GetId(),
+			Name:       r.GetName(),
+			Type:       r.GetType(),
+			Size:       r.GetSize(),
+			StoredSize: r.GetSize(),
+			Revision:   r.GetRevision(),
+			CreatedAt:  r.GetCreatedAt(),
+			UpdatedAt:  r.GetUpdatedAt(),
+			ExpiresAt:  r.GetExpiresAt(),
+			RotateDays: r.GetRotateEvery() / (24 * 60 * 60),
+			DueAt:      r.GetDueAt(),
+		}
+		if m, ok := metadata[r.GetId()]; ok {
+			entries[i].Size = realSize(r, m)
+			entries[i].Tags = m.Tags
+			entries[i].Metadata = m.Values
+		}
+	}
+	return entries
+}
+
+func printTable(resources []*pb.GetResourceResponse, metadata map[int64]*models.ResourceMetadata) {
+	if len(resources) == 0 {
+		fmt.Println("No secrets found.")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	now := time.Now()
+	due := 0
+	fmt.Fprintln(w, "ID\tNAME\tTYPE\tSIZE\tCREATED\tUPDATED\tDUE\tTAGS")
+	for _, r := range resources {
+		size := r.GetSize()
+		var tags []string
+		if m, ok := metadata[r.GetId()]; ok {
+			size = realSize(r, m)
+			tags = m.Tags
+		}
+		dueAt := formatTimestamp(r.GetDueAt())
+		if mark := dueMark(r, now); mark != "" {
+			dueAt += " " + mark
+			due++
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			r.GetId(), r.GetName(), r.GetType(), formatSize(size),
+			formatTimestamp(r.GetCreatedAt()), formatTimestamp(r.GetUpdatedAt()), dueAt, strings.Join(tags, ","))
+	}
+	w.Flush()
+
+	if due > 0 {
+		fmt.Fprintf(os.Stderr, "⚠ %d secrets are expired or due for rotation soon, see 'gophkeeper due'\n", due)
+	}
+}
+
+func printJSON(resources []*pb.GetResourceResponse, metadata map[int64]*models.ResourceMetadata) {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(toListEntries(resources, metadata)); err != nil {
+		fmt.Fprintf(os.Stderr, "✗ Failed to encode JSON: %v\n", err)
+	}
+}
+
+func printYAML(resources []*pb.GetResourceResponse, metadata map[int64]*models.ResourceMetadata) {
+	encoder := yaml.NewEncoder(os.Stdout)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(toListEntries(resources, metadata)); err != nil {
+		fmt.Fprintf(os.Stderr, "✗ Failed to encode YAML: %v\n", err)
+	}
+	encoder.Close()
+}
+
+func printNames(resources []*pb.GetResourceResponse, _ string) {
+	for _, r := range resources {
+		fmt.Println(r.GetName())
+	}
+}
+
+// realSize returns the size of the data before padding and encryption
+// Secrets stored before sizes were recorded fall back to the stored size
+func realSize(r *pb.GetResourceResponse, metadata *models.ResourceMetadata) int64 {
+	if metadata.Size > 0 {
+		return metadata.Size
+	}
+	return r.GetSize()
+}
+
+// hasAllTags reports whether metadata has every one of the tags
+func hasAllTags(metadata *models.ResourceMetadata, tags []string) bool {
+	if metadata == nil {
+		return false
+	}
+	for _, tag := range tags {
+		if !metadata.HasTag(tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// formatSize formats a size in bytes using binary units, e.g. 1.5 MiB
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+// formatTimestamp shortens a server timestamp for table output
+func formatTimestamp(value string) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return t.Format("2006-01-02 15:04")
+}
+
+func init() {
+	rootCmd.AddCommand(listCmd)
+	listCmd.Flags().StringP("type", "t", "", "Filter by type: credentials | text | binary | card | ssh_key | totp or a custom type")
+	listCmd.Flags().StringP("name", "n", "", "Filter by name pattern, e.g. 'prod/*/password'")
+	listCmd.Flags().String("sort", "", "Sort by: name | created_at | updated_at | size (newest first by default)")
+	listCmd.Flags().Bool("desc", false, "Sort in descending order")
+	listCmd.Flags().StringP("output", "o", "table", "Output format: table | json | yaml | names | tree")
+	listCmd.Flags().StringArray("tag", nil, "Show only secrets with this tag, may be repeated")
+}
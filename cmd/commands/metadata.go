@@ -0,0 +1,96 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+)
+
+// addMetadataFlags registers flags for tags and key/value metadata
+func addMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray("tag", nil, "Tag, may be repeated")
+	cmd.Flags().StringArray("meta", nil, "Metadata key=value, may be repeated (an empty value removes the key)")
+}
+
+// readMetadata applies tags and metadata from flags to a copy of current
+// Returns nil if no metadata flags were given
+func readMetadata(cmd *cobra.Command, current *models.ResourceMetadata) (*models.ResourceMetadata, error) {
+	tags, _ := cmd.Flags().GetStringArray("tag")
+	untags, _ := cmd.Flags().GetStringArray("untag") // registered only by commands changing existing secrets
+	values, _ := cmd.Flags().GetStringArray("meta")
+	if len(tags) == 0 && len(untags) == 0 && len(values) == 0 {
+		return nil, nil
+	}
+
+	metadata := &models.ResourceMetadata{}
+	if current != nil {
+		metadata.Tags = append([]string(nil), current.Tags...)
+		for key, value := range current.Values {
+			metadata.SetValue(key, value)
+		}
+		metadata.Size = current.Size
+		metadata.File = current.File
+	}
+
+	for _, tag := range untags {
+		metadata.RemoveTag(tag)
+	}
+	for _, tag := range tags {
+		metadata.AddTag(tag)
+	}
+	for _, pair := range values {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid metadata %q, expected key=value", pair)
+		}
+		metadata.SetValue(key, value)
+	}
+
+	return metadata, nil
+}
+
+// encryptMetadata encrypts metadata for the server, empty metadata is sent as an empty payload
+func encryptMetadata(cryptoService *crypto.CryptoService, metadata *models.ResourceMetadata) ([]byte, error) {
+	if metadata.IsEmpty() {
+		return []byte{}, nil
+	}
+	return cryptoService.EncryptJSON(metadata)
+}
+
+// decryptMetadata decrypts the metadata of a resource, resources without metadata get an empty one
+func decryptMetadata(cryptoService *crypto.CryptoService, data []byte) (*models.ResourceMetadata, error) {
+	metadata := &models.ResourceMetadata{}
+	if len(data) == 0 {
+		return metadata, nil
+	}
+	if err := cryptoService.DecryptJSON(data, metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// printMetadata prints the original file, tags and metadata values sorted by key
+func printMetadata(metadata *models.ResourceMetadata) {
+	if file := metadata.File; file != nil {
+		fmt.Printf("File: %s (%s, modified %s)\n", file.Name, file.Mode, file.ModTime.Local().Format("2006-01-02 15:04"))
+	}
+	if len(metadata.Tags) > 0 {
+		fmt.Printf("Tags: %s\n", strings.Join(metadata.Tags, ", "))
+	}
+
+	keys := make([]string, 0, len(metadata.Values))
+	for key := range metadata.Values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Printf("Meta %s: %s\n", key, metadata.Values[key])
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/spf13/cobra"
+)
+
+// migrateNamesCmd represents the migrate-names command
+var migrateNamesCmd = &cobra.Command{
+	Use:   "migrate-names",
+	Short: "Encrypt names of secrets stored with plaintext names",
+	Long: `Encrypt names of secrets stored with plaintext names.
+
+Secret names used to be stored on the server in plaintext. This command
+replaces them with encrypted names and their blind indexes, so the server
+no longer sees them. Secrets in trash are migrated after they are restored.
+Until then the client lists secrets to find plaintext names locally instead of
+sending the names it looks up to the server.
+
+A plaintext name that is also used by a secret with an encrypted name is not
+migrated: rename or delete one of the two first.
+
+Examples:
+  gophkeeper migrate-names --dry-run
+  gophkeeper migrate-names`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		resources, err := resourceClient.ListAllResources(&pb.ListResourcesRequest{})
+		if err != nil {
+			fmt.Printf("✗ Failed to list secrets: %v\n", err)
+			return
+		}
+
+		// Names of secrets already migrated or created with encrypted names
+		encrypted := make(map[string]bool)
+		for _, r := range resources {
+			if len(r.GetEncryptedName()) > 0 {
+				encrypted[displayName(cryptoService, r)] = true
+			}
+		}
+
+		var plaintext []*pb.GetResourceResponse
+		var conflicts []string
+		for _, r := range resources {
+			if len(r.GetEncryptedName()) > 0 {
+				continue
+			}
+			if encrypted[r.GetName()] {
+				conflicts = append(conflicts, r.GetName())
+				continue
+			}
+			plaintext = append(plaintext, r)
+		}
+
+		if len(conflicts) > 0 {
+			fmt.Printf("✗ %d secret(s) exist both with a plaintext and an encrypted name and are not migrated: %s\n",
+				len(conflicts), strings.Join(conflicts, ", "))
+			fmt.Println("Rename or delete one of each pair, e.g. with 'gophkeeper mv', and run the command again")
+		}
+
+		if len(plaintext) == 0 {
+			if len(conflicts) == 0 {
+				tokenStore.SetNamesMigrated(true)
+				fmt.Println("✓ All secret names are already encrypted")
+			}
+			return
+		}
+
+		if dryRun {
+			fmt.Printf("%d secret(s) have plaintext names:\n", len(plaintext))
+			for _, r := range plaintext {
+				fmt.Printf("  %s\n", r.GetName())
+			}
+			return
+		}
+
+		migrated := 0
+		var failed []string
+		for _, r := range plaintext {
+			nameIndex, encryptedName, err := sealName(cryptoService, r.GetName())
+			if err == nil {
+				_, err = resourceClient.RenameResource(r.GetId(), r.GetRevision(), nameIndex, encryptedName)
+			}
+			if err != nil {
+				fmt.Printf("✗ Failed to migrate secret '%s': %v\n", r.GetName(), err)
+				failed = append(failed, r.GetName())
+				continue
+			}
+			migrated++
+		}
+
+		fmt.Printf("✓ Encrypted names of %d secret(s)\n", migrated)
+		if len(failed) == 0 && len(conflicts) == 0 {
+			// Names are no longer looked up in plaintext
+			tokenStore.SetNamesMigrated(true)
+		}
+		if len(failed) > 0 {
+			fmt.Printf("✗ %d secret(s) were not migrated: %s. Run the command again to retry.\n", len(failed), strings.Join(failed, ", "))
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(migrateNamesCmd)
+	migrateNamesCmd.Flags().Bool("dry-run", false, "Only list secrets with plaintext names")
+}
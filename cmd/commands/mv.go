@@ -0,0 +1,158 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mvCmd represents the mv command
+var mvCmd = &cobra.Command{
+	Use:   "mv <source> <destination>",
+	Short: "Rename a secret or move a folder",
+	Long: `Rename a secret or move a whole folder of secrets.
+
+If <source> is a secret, it is renamed to <destination>. A destination ending
+with '/' keeps the secret name and moves it into that folder.
+Otherwise <source> is treated as a folder and everything inside it is moved.
+
+Examples:
+  gophkeeper mv prod/db/password prod/db/root-password
+  gophkeeper mv prod/db/password staging/db/
+  gophkeeper mv prod/db staging/db`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		source, destination := args[0], args[1]
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		resource, err := findResource(cryptoService, source)
+		if err == nil {
+			if strings.HasSuffix(destination, "/") {
+				destination += path.Base(displayName(cryptoService, resource))
+			}
+
+			nameIndex, encryptedName, err := sealName(cryptoService, destination)
+			if err != nil {
+				fmt.Printf("✗ Invalid name: %v\n", err)
+				return
+			}
+
+			_, err = resourceClient.RenameResource(resource.GetId(), resource.GetRevision(), nameIndex, encryptedName)
+			if err != nil {
+				if isRevisionConflict(err) {
+					printConflict(cryptoService, resource, resource.GetRevision())
+					return
+				}
+				if status.Code(err) == codes.AlreadyExists {
+					fmt.Printf("✗ Secret '%s' already exists\n", destination)
+					return
+				}
+				fmt.Printf("✗ Failed to rename secret: %v\n", err)
+				return
+			}
+
+			fmt.Printf("✓ Secret '%s' renamed to '%s'\n", source, strings.Trim(destination, models.PathSeparator))
+			return
+		}
+		if status.Code(err) != codes.NotFound {
+			fmt.Printf("✗ Failed to get secret: %v\n", err)
+			return
+		}
+
+		moveFolder(cryptoService, source, destination)
+	},
+}
+
+// moveFolder renames every secret inside the folder from so that it lies in the folder to
+// Folders exist only in decrypted names, so each secret is renamed separately
+func moveFolder(cryptoService *crypto.CryptoService, from, to string) {
+	from, err := models.CleanResourceName(from)
+	if err != nil {
+		fmt.Printf("✗ Invalid folder: %v\n", err)
+		return
+	}
+	to, err = models.CleanPathPrefix(to)
+	if err != nil {
+		fmt.Printf("✗ Invalid folder: %v\n", err)
+		return
+	}
+
+	resources, err := resourceClient.ListAllResources(&pb.ListResourcesRequest{})
+	if err != nil {
+		fmt.Printf("✗ Failed to list secrets: %v\n", err)
+		return
+	}
+	revealNames(cryptoService, resources)
+
+	existing := make(map[string]bool, len(resources))
+	for _, r := range resources {
+		existing[r.GetName()] = true
+	}
+
+	var toMove []*pb.GetResourceResponse
+	newNames := make(map[int64]string)
+	for _, r := range resources {
+		if !models.IsInFolder(r.GetName(), from) {
+			continue
+		}
+		newName := strings.TrimPrefix(r.GetName(), from+models.PathSeparator)
+		if to != "" {
+			newName = to + models.PathSeparator + newName
+		}
+		if existing[newName] {
+			fmt.Printf("✗ Folder '%s' already contains secrets with the same names, nothing was moved\n", to)
+			return
+		}
+		toMove = append(toMove, r)
+		newNames[r.GetId()] = newName
+	}
+
+	if len(toMove) == 0 {
+		fmt.Printf("✗ Nothing found at '%s'\n", from)
+		return
+	}
+
+	// Each secret is renamed separately, so on failure report exactly what was moved
+	var moved []string
+	for _, r := range toMove {
+		nameIndex, encryptedName, err := sealName(cryptoService, newNames[r.GetId()])
+		if err == nil {
+			_, err = resourceClient.RenameResource(r.GetId(), r.GetRevision(), nameIndex, encryptedName)
+		}
+		if err != nil {
+			fmt.Printf("✗ Failed to move secret '%s': %v\n", r.GetName(), err)
+			if len(moved) > 0 {
+				fmt.Printf("Moved %d of %d secret(s) before the failure:\n", len(moved), len(toMove))
+				for _, name := range moved {
+					fmt.Printf("    %s\n", name)
+				}
+			} else {
+				fmt.Println("Nothing was moved")
+			}
+			fmt.Printf("Run 'gophkeeper mv %s %s' again to move the rest\n", from, to+models.PathSeparator)
+			return
+		}
+		moved = append(moved, fmt.Sprintf("%s → %s", r.GetName(), newNames[r.GetId()]))
+	}
+
+	fmt.Printf("✓ Moved %d secret(s) from '%s' to '%s'\n", len(moved), from, to)
+}
+
+func init() {
+	rootCmd.AddCommand(mvCmd)
+}
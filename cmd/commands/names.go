@@ -0,0 +1,134 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Secret names are encrypted on the client. The server only stores the encrypted name
+// and its blind index, which is sent in place of the name for exact-name lookups.
+// Folders, name patterns and sorting by name are therefore handled on the client.
+// Plaintext names are never sent for lookups: secrets stored before names were encrypted
+// are matched against the listing on the client until 'gophkeeper migrate-names' has run.
+
+// sealName validates a secret name and returns its blind index and encrypted form
+func sealName(cryptoService *crypto.CryptoService, name string) (string, []byte, error) {
+	name, err := models.CleanResourceName(name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	encryptedName, err := cryptoService.EncryptName(name)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encrypt name: %w", err)
+	}
+	return cryptoService.NameIndex(name), encryptedName, nil
+}
+
+// findResource gets a secret by name, looking it up by the blind index first
+// and then among secrets not migrated with 'gophkeeper migrate-names'
+func findResource(cryptoService *crypto.CryptoService, name string) (*pb.GetResourceResponse, error) {
+	name, err := models.CleanResourceName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := resourceClient.GetResourceByName(cryptoService.NameIndex(name))
+	if status.Code(err) != codes.NotFound {
+		return resource, err
+	}
+
+	legacy, legacyErr := findPlaintextNamed(name)
+	if legacyErr != nil {
+		return nil, legacyErr
+	}
+	if legacy == nil {
+		return nil, err
+	}
+	return resourceClient.GetResource(legacy.GetId())
+}
+
+// secretExists checks whether a secret with the name exists, under its blind index or
+// as a plaintext name stored before names were encrypted. Data is never downloaded
+// Returns an error if the server could not be asked, not found is not an error
+func secretExists(cryptoService *crypto.CryptoService, name string) (bool, error) {
+	name, err := models.CleanResourceName(name)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = resourceClient.GetResourceInfoByName(cryptoService.NameIndex(name))
+	if err == nil {
+		return true, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return false, err
+	}
+
+	legacy, err := findPlaintextNamed(name)
+	if err != nil {
+		return false, err
+	}
+	return legacy != nil, nil
+}
+
+// findPlaintextNamed finds a secret stored with the plaintext name, nil if there is none
+// The name is matched against the listing on the client, so a name that does not exist
+// is never sent to the server. Once no plaintext names are left, this is remembered
+// and nothing is listed anymore
+func findPlaintextNamed(name string) (*pb.GetResourceResponse, error) {
+	if migrated, _ := tokenStore.NamesMigrated(); migrated {
+		return nil, nil
+	}
+
+	resources, err := resourceClient.ListAllResources(&pb.ListResourcesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets: %w", err)
+	}
+
+	var found *pb.GetResourceResponse
+	plaintext := 0
+	for _, r := range resources {
+		if len(r.GetEncryptedName()) > 0 {
+			continue
+		}
+		plaintext++
+		if r.GetName() == name {
+			found = r
+		}
+	}
+	if plaintext == 0 {
+		tokenStore.SetNamesMigrated(true)
+	}
+	return found, nil
+}
+
+// displayName returns the plaintext name of a secret
+// Encrypted names are shown as their ID if the secrets are locked or the name cannot be decrypted
+func displayName(cryptoService *crypto.CryptoService, resource *pb.GetResourceResponse) string {
+	if len(resource.GetEncryptedName()) == 0 {
+		return resource.GetName()
+	}
+	if cryptoService != nil {
+		if name, err := cryptoService.DecryptName(resource.GetEncryptedName()); err == nil {
+			return name
+		}
+	}
+	return fmt.Sprintf("<encrypted #%d>", resource.GetId())
+}
+
+// revealNames replaces blind indexes with plaintext names in listed resources
+func revealNames(cryptoService *crypto.CryptoService, resources []*pb.GetResourceResponse) {
+	for _, r := range resources {
+		r.Name = proto.String(displayName(cryptoService, r))
+	}
+}
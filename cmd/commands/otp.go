@@ -0,0 +1,74 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+)
+
+// otpCmd represents the otp command
+var otpCmd = &cobra.Command{
+	Use:   "otp <name>",
+	Short: "Print the current one-time password of a TOTP secret",
+	Long: `Print the current one-time password of a totp secret and the seconds it stays valid.
+The code is printed alone on stdout, the remaining time goes to stderr.
+
+Examples:
+  gophkeeper set -n 2fa/github -t totp -v 'otpauth://totp/GitHub:octocat?secret=JBSWY3DPEHPK3PXP'
+  gophkeeper otp 2fa/github
+  gophkeeper otp 2fa/github --wait 5 | pbcopy`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		wait, _ := cmd.Flags().GetInt("wait")
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		resource, err := findResource(cryptoService, name)
+		if err != nil {
+			fmt.Printf("✗ Secret '%s' not found: %v\n", name, err)
+			return
+		}
+		if resource.GetType() != string(models.TypeTOTP) {
+			fmt.Printf("✗ Secret '%s' is not a totp secret\n", name)
+			return
+		}
+
+		totp, err := decryptTOTP(cryptoService, resource.GetData())
+		if err != nil {
+			fmt.Printf("✗ Failed to decrypt TOTP secret: %v\n", err)
+			return
+		}
+
+		// A code about to expire is useless to paste, wait for the next one
+		now := time.Now()
+		if remaining := totp.Remaining(now); wait > 0 && remaining < time.Duration(wait)*time.Second {
+			fmt.Fprintf(os.Stderr, "Waiting %ds for the next code...\n", int(remaining.Seconds()))
+			time.Sleep(remaining)
+			now = time.Now()
+		}
+
+		code, err := totp.Code(now)
+		if err != nil {
+			fmt.Printf("✗ Failed to generate code: %v\n", err)
+			return
+		}
+		fmt.Println(code)
+		fmt.Fprintf(os.Stderr, "%ds remaining\n", int(totp.Remaining(now).Seconds()))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(otpCmd)
+	otpCmd.Flags().Int("wait", 0, "Wait for the next code if the current one expires in less than this many seconds")
+}
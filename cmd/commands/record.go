@@ -0,0 +1,105 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+)
+
+// readRecord builds a record of a user-defined type from --field flags and interactive prompts
+// Fields not given as flags are prompted for in the order of the definition, secret fields
+// are always prompted hidden and never taken from flags
+// If current is not nil, empty answers keep its values
+func readRecord(cmd *cobra.Command, definition *models.TypeDefinition, current *models.Record) (*models.Record, error) {
+	record := &models.Record{Fields: make(map[string]string, len(definition.Fields))}
+	if current != nil {
+		for name, value := range current.Fields {
+			record.Fields[name] = value
+		}
+	}
+
+	fields, _ := cmd.Flags().GetStringArray("field")
+	given := make(map[string]string, len(fields))
+	for _, field := range fields {
+		name, value, ok := strings.Cut(field, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid field %q, expected name=value", field)
+		}
+		f, ok := definition.Field(name)
+		if !ok {
+			return nil, fmt.Errorf("type '%s' has no field '%s'", definition.Name, name)
+		}
+		if f.Secret {
+			return nil, fmt.Errorf("field '%s' is secret and is prompted for, do not pass it as a flag", f.Name)
+		}
+		given[f.Name] = value
+	}
+
+	for _, f := range definition.Fields {
+		value, ok := given[f.Name]
+		if !ok {
+			var err error
+			if f.Secret {
+				prompt := f.DisplayName() + ": "
+				if record.Fields[f.Name] != "" {
+					prompt = f.DisplayName() + " (leave empty to keep current): "
+				}
+				value, err = promptPassword(prompt)
+			} else {
+				value, err = promptLine(withDefault(f.DisplayName(), record.Fields[f.Name]))
+			}
+			if err != nil {
+				return nil, err
+			}
+			if value == "" {
+				continue
+			}
+		}
+
+		if value == "" {
+			delete(record.Fields, f.Name)
+		} else {
+			record.Fields[f.Name] = value
+		}
+	}
+
+	if err := definition.ValidateRecord(record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// decryptRecord decrypts the payload of a resource of a user-defined type
+func decryptRecord(cryptoService *crypto.CryptoService, data []byte) (*models.Record, error) {
+	var record models.Record
+	if err := cryptoService.DecryptJSON(data, &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// printRecord prints the fields of a record in the order of the definition, secret fields are masked unless reveal is set
+// Fields no longer in the definition are printed last
+func printRecord(definition *models.TypeDefinition, record *models.Record, reveal bool) {
+	printed := make(map[string]bool, len(definition.Fields))
+	for _, f := range definition.Fields {
+		printed[f.Name] = true
+		if value, ok := record.Fields[f.Name]; ok {
+			if f.Secret && !reveal {
+				value = maskSecret(value)
+			}
+			fmt.Printf("%s: %s\n", f.DisplayName(), value)
+		}
+	}
+	for name, value := range record.Fields {
+		if !printed[name] {
+			fmt.Printf("%s: %s\n", name, value)
+		}
+	}
+}
@@ -81,6 +81,7 @@ var rootCmd = &cobra.Command{
 			"register": true,
 			"login":    true,
 			"help":     true,
+			"generate": true,
 		}
 
 		softAuthCommands := map[string]bool{
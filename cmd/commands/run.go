@@ -0,0 +1,178 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"regexp"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+)
+
+// envNamePattern matches valid environment variable names
+var envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// envMapping maps an environment variable to a secret reference (name[:field])
+type envMapping struct {
+	Name string
+	Ref  string
+}
+
+// runCmd represents the run command
+var runCmd = &cobra.Command{
+	Use:   "run [--env NAME=secret[:field]]... [--env-file file] -- command [args...]",
+	Short: "Run a command with secrets in its environment",
+	Long: `Run a command with secrets in its environment.
+
+Each --env maps an environment variable to a secret as name[:field]. Without a field
+credentials give the password, cards the number, TOTP secrets the current code,
+SSH keys the private key and text secrets their value.
+
+An --env-file holds one NAME=secret[:field] mapping per line, empty lines and lines
+starting with '#' are ignored. --env takes precedence over the file.
+
+Secrets are decrypted in memory and passed to the command only, they are never
+written to disk. The exit code of the command is returned.
+
+Examples:
+  gophkeeper run --env DB_PASS=prod/db:password -- ./app
+  gophkeeper run --env AWS_ACCESS_KEY_ID=aws:username --env AWS_SECRET_ACCESS_KEY=aws -- aws s3 ls
+  gophkeeper run --env-file .env.gophkeeper -- docker compose up`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		envFlags, _ := cmd.Flags().GetStringArray("env")
+		envFile, _ := cmd.Flags().GetString("env-file")
+
+		var mappings []envMapping
+		if envFile != "" {
+			fileMappings, err := readEnvFile(envFile)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "✗ %v\n", err)
+				os.Exit(1)
+			}
+			mappings = append(mappings, fileMappings...)
+		}
+		for _, flag := range envFlags {
+			mapping, err := parseEnvMapping(flag)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "✗ %v\n", err)
+				os.Exit(1)
+			}
+			mappings = append(mappings, mapping)
+		}
+		if len(mappings) == 0 {
+			fmt.Fprintln(os.Stderr, "✗ No secrets to inject, use --env or --env-file")
+			os.Exit(1)
+		}
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			os.Exit(1)
+		}
+
+		resolver := newSecretResolver(cryptoService)
+		env := os.Environ()
+		for _, m := range mappings {
+			value, err := resolver.Resolve(m.Ref)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "✗ %s: %v\n", m.Name, err)
+				os.Exit(1)
+			}
+			// Later entries win, so mappings override the inherited environment
+			env = append(env, m.Name+"="+value)
+		}
+
+		os.Exit(runWithEnv(args, env))
+	},
+}
+
+// runWithEnv runs a command with the given environment, forwarding interrupts to it
+// Returns the exit code of the command
+func runWithEnv(args, env []string) int {
+	child := exec.Command(args[0], args[1:]...)
+	child.Env = env
+	child.Stdin = os.Stdin
+	child.Stdout = os.Stdout
+	child.Stderr = os.Stderr
+
+	if err := child.Start(); err != nil {
+		fmt.Fprintf(os.Stderr, "✗ Failed to start '%s': %v\n", args[0], err)
+		return 127
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		for sig := range signals {
+			_ = child.Process.Signal(sig)
+		}
+	}()
+
+	if err := child.Wait(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.ExitCode()
+		}
+		fmt.Fprintf(os.Stderr, "✗ '%s' failed: %v\n", args[0], err)
+		return 1
+	}
+	return 0
+}
+
+// parseEnvMapping parses NAME=secret[:field]
+func parseEnvMapping(value string) (envMapping, error) {
+	name, ref, ok := strings.Cut(value, "=")
+	name, ref = strings.TrimSpace(name), strings.TrimSpace(ref)
+	if !ok || ref == "" {
+		return envMapping{}, fmt.Errorf("invalid mapping %q, expected NAME=secret[:field]", value)
+	}
+	if !envNamePattern.MatchString(name) {
+		return envMapping{}, fmt.Errorf("invalid environment variable name %q", name)
+	}
+	return envMapping{Name: name, Ref: ref}, nil
+}
+
+// readEnvFile reads NAME=secret[:field] mappings, one per line
+func readEnvFile(path string) ([]envMapping, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read env file: %w", err)
+	}
+	defer file.Close()
+
+	var mappings []envMapping
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		mapping, err := parseEnvMapping(strings.TrimPrefix(text, "export "))
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		mappings = append(mappings, mapping)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read env file: %w", err)
+	}
+	return mappings, nil
+}
+
+func init() {
+	rootCmd.AddCommand(runCmd)
+	// Flags after the command name belong to the command
+	runCmd.Flags().SetInterspersed(false)
+	runCmd.Flags().StringArray("env", nil, "Environment variable as NAME=secret[:field], may be repeated")
+	runCmd.Flags().String("env-file", "", "File with NAME=secret[:field] mappings, one per line")
+}
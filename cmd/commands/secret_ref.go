@@ -0,0 +1,139 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+)
+
+// defaultFields are the fields a reference without a field resolves to
+// Text and binary secrets have a single value
+var defaultFields = map[models.ResourceType]string{
+	models.TypeCredentials: "password",
+	models.TypeCard:        "number",
+	models.TypeTOTP:        "code",
+	models.TypeSSHKey:      "private_key",
+}
+
+// secretResolver resolves references to secret fields written as name[:field]
+// The field follows the last ':', so names containing ':' must always name a field.
+// Each secret is fetched and decrypted once, values only live in memory
+type secretResolver struct {
+	cryptoService *crypto.CryptoService
+	resources     map[string]*pb.GetResourceResponse
+}
+
+func newSecretResolver(cryptoService *crypto.CryptoService) *secretResolver {
+	return &secretResolver{
+		cryptoService: cryptoService,
+		resources:     make(map[string]*pb.GetResourceResponse),
+	}
+}
+
+// Resolve returns the value of the referenced field
+func (r *secretResolver) Resolve(ref string) (string, error) {
+	name, field := ref, ""
+	if i := strings.LastIndex(ref, ":"); i >= 0 {
+		name, field = ref[:i], ref[i+1:]
+	}
+	if strings.TrimSpace(name) == "" {
+		return "", fmt.Errorf("invalid secret reference %q, expected name[:field]", ref)
+	}
+	return r.Field(name, field)
+}
+
+// Field returns a field of the named secret, an empty field selects the default one
+func (r *secretResolver) Field(name, field string) (string, error) {
+	name = strings.TrimSpace(name)
+	resource, ok := r.resources[name]
+	if !ok {
+		var err error
+		resource, err = findResource(r.cryptoService, name)
+		if err != nil {
+			return "", fmt.Errorf("secret '%s' not found: %w", name, err)
+		}
+		r.resources[name] = resource
+	}
+
+	value, err := secretField(r.cryptoService, resource, strings.TrimSpace(field))
+	if err != nil {
+		return "", fmt.Errorf("secret '%s': %w", name, err)
+	}
+	return value, nil
+}
+
+// secretField decrypts a secret and returns one of its fields, an empty field selects the default one
+func secretField(cryptoService *crypto.CryptoService, resource *pb.GetResourceResponse, field string) (string, error) {
+	resourceType := models.ResourceType(resource.GetType())
+	if field == "" {
+		field = defaultFields[resourceType]
+	}
+
+	var value string
+	var found bool
+	switch resourceType {
+	case models.TypeCredentials:
+		credential, err := decryptCredential(cryptoService, resource.GetData())
+		if err != nil {
+			return "", fmt.Errorf("decryption failed: %w", err)
+		}
+		value, found = credential.Field(field)
+	case models.TypeCard:
+		card, err := decryptCard(cryptoService, resource.GetData())
+		if err != nil {
+			return "", fmt.Errorf("decryption failed: %w", err)
+		}
+		value, found = card.Field(field)
+	case models.TypeTOTP:
+		totp, err := decryptTOTP(cryptoService, resource.GetData())
+		if err != nil {
+			return "", fmt.Errorf("decryption failed: %w", err)
+		}
+		value, found = totp.Field(field)
+	case models.TypeSSHKey:
+		key, err := decryptSSHKey(cryptoService, resource.GetData())
+		if err != nil {
+			return "", fmt.Errorf("decryption failed: %w", err)
+		}
+		value, found = key.Field(field)
+	case models.TypeText, models.TypeBinary:
+		if field != "" && field != "value" {
+			return "", fmt.Errorf("field '%s' not found, this secret only has a value", field)
+		}
+		data, err := cryptoService.DecryptData(resource.GetData())
+		if err != nil {
+			return "", fmt.Errorf("decryption failed: %w", err)
+		}
+		if !utf8.Valid(data) {
+			return "", fmt.Errorf("the value is binary data")
+		}
+		return string(data), nil
+	default:
+		if field == "" {
+			return "", fmt.Errorf("secrets of type '%s' have several fields, name one as name:field", resourceType)
+		}
+		definition, err := lookupType(string(resourceType))
+		if err != nil {
+			return "", err
+		}
+		record, err := decryptRecord(cryptoService, resource.GetData())
+		if err != nil {
+			return "", fmt.Errorf("decryption failed: %w", err)
+		}
+		if f, ok := definition.Field(field); ok {
+			value, found = record.Fields[f.Name], true
+		}
+	}
+
+	if !found {
+		return "", fmt.Errorf("field '%s' not found", field)
+	}
+	return value, nil
+}
@@ -4,10 +4,17 @@ Copyright © 2025 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // setCmd represents the set command
@@ -20,8 +27,38 @@ Examples:
   # Store a text value
   gophkeeper set -n "secret" -v "my-password" -t text
 
+  # Store credentials, the password is prompted for and never passed as a flag
+  gophkeeper set -n "github" -t credentials --username octocat --url https://github.com
+
+  # Store credentials with a generated password, it is never printed
+  gophkeeper set -n "github" -t credentials --username octocat --generate
+  gophkeeper set -n "vpn" -t credentials --username me --generate --passphrase --words 5
+
+  # Store a card, the number, CVV and PIN are prompted for
+  gophkeeper set -n "cards/visa" -t card --holder "JOHN DOE" --expiry 12/27
+
+  # Store an SSH key, the passphrase of a protected key is prompted for
+  gophkeeper set -n "keys/github" -t ssh_key -f ~/.ssh/id_ed25519
+
+  # Store a 2FA seed from an otpauth URI or a base32 secret (prompted if omitted)
+  gophkeeper set -n "2fa/github" -t totp -v 'otpauth://totp/GitHub:octocat?secret=JBSWY3DPEHPK3PXP'
+  gophkeeper set -n "2fa/aws" -t totp --issuer AWS --account admin --digits 6
+
+  # Store a secret of a type defined with 'gophkeeper types define', secret fields are prompted for
+  gophkeeper set -n "home/wifi" -t wifi --field ssid=HomeNet
+
   # Store a file (for large data)
-  gophkeeper set -n "bigfile" -f /path/to/file -t binary`,
+  gophkeeper set -n "bigfile" -f /path/to/file -t binary
+
+  # Tags and metadata are encrypted together with the value
+  gophkeeper set -n "aws" -v "key" -t text --tag prod --tag cloud --meta owner=ops
+
+  # Remind to rotate a password every 90 days, or set a hard expiry date
+  gophkeeper set -n "db" -t credentials --username admin --rotate-every 90d
+  gophkeeper set -n "api-token" -v "token" -t text --expires 2026-12-31
+
+  # Names are unique, use slash-separated paths to organize them in folders
+  gophkeeper set -n "prod/db/password" -v "my-password" -t text`,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		cryptoService, err := masterKeyStore.GetCryptoService()
@@ -35,61 +72,240 @@ Examples:
 		filePath, _ := cmd.Flags().GetString("file")
 		secretType, _ := cmd.Flags().GetString("type")
 
-		validTypes := map[string]bool{
-			"credentials": true,
-			"text":        true,
-			"binary":      true,
-			"card":        true,
-		}
-		if !validTypes[secretType] {
-			fmt.Println("Invalid type. Use: credentials, text, binary, or card")
+		definition, err := lookupType(secretType)
+		if err != nil {
+			fmt.Printf("✗ %v\n", err)
 			return
 		}
-
-		if value == "" && filePath == "" {
-			fmt.Println("✗ Either --value or --file must be provided")
+		if generate, _ := cmd.Flags().GetBool("generate"); generate && secretType != string(models.TypeCredentials) {
+			fmt.Println("✗ --generate is only supported for credentials")
 			return
 		}
-		if value != "" && filePath != "" {
-			fmt.Println("✗ Cannot use both --value and --file")
+		expiry, err := readExpiry(cmd)
+		if err != nil {
+			fmt.Printf("✗ %v\n", err)
 			return
 		}
 
-		var dataToEncrypt []byte
+		var plaintext []byte
+		var fileInfo *models.FileInfo // set only if the value is read from a file
+
+		switch models.ResourceType(secretType) {
+		case models.TypeCredentials:
+			if value != "" || filePath != "" {
+				fmt.Println("✗ Credentials are entered interactively, --value and --file are not supported")
+				return
+			}
+
+			credential, err := readCredential(cmd, nil)
+			if err != nil {
+				fmt.Printf("✗ Failed to read credential: %v\n", err)
+				return
+			}
+
+			plaintext, err = json.Marshal(credential)
+			if err != nil {
+				fmt.Printf("✗ Failed to encode credential: %v\n", err)
+				return
+			}
+		case models.TypeCard:
+			if value != "" || filePath != "" {
+				fmt.Println("✗ Cards are entered interactively, --value and --file are not supported")
+				return
+			}
+
+			card, err := readCard(cmd, nil)
+			if err != nil {
+				fmt.Printf("✗ Invalid card: %v\n", err)
+				return
+			}
+			warnIfExpired(card)
+
+			plaintext, err = json.Marshal(card)
+			if err != nil {
+				fmt.Printf("✗ Failed to encode card: %v\n", err)
+				return
+			}
+		case models.TypeTOTP:
+			if value != "" && filePath != "" {
+				fmt.Println("✗ Cannot use both --value and --file")
+				return
+			}
+
+			input := value
+			if filePath != "" {
+				data, err := os.ReadFile(filePath)
+				if err != nil {
+					fmt.Printf("✗ Failed to read file: %v\n", err)
+					return
+				}
+				input = strings.TrimSpace(string(data))
+			}
+
+			totp, err := readTOTP(cmd, input, nil)
+			if err != nil {
+				fmt.Printf("✗ Invalid TOTP secret: %v\n", err)
+				return
+			}
+
+			plaintext, err = json.Marshal(totp)
+			if err != nil {
+				fmt.Printf("✗ Failed to encode TOTP secret: %v\n", err)
+				return
+			}
+		case models.TypeSSHKey:
+			if value != "" && filePath != "" {
+				fmt.Println("✗ Cannot use both --value and --file")
+				return
+			}
 
-		if filePath != "" {
-			dataToEncrypt, err = os.ReadFile(filePath)
+			var privateKey []byte
+			if value != "" {
+				privateKey = []byte(value)
+			}
+			if filePath != "" {
+				privateKey, fileInfo, err = readFile(filePath)
+				if err != nil {
+					fmt.Printf("✗ Failed to read file: %v\n", err)
+					return
+				}
+			}
+
+			key, err := readSSHKey(cmd, privateKey, nil)
 			if err != nil {
-				fmt.Printf("✗ Failed to read file: %v\n", err)
+				fmt.Printf("✗ Invalid SSH key: %v\n", err)
+				return
+			}
+
+			plaintext, err = json.Marshal(key)
+			if err != nil {
+				fmt.Printf("✗ Failed to encode SSH key: %v\n", err)
+				return
+			}
+		case models.TypeText, models.TypeBinary:
+			if value == "" && filePath == "" {
+				fmt.Println("✗ Either --value or --file must be provided")
+				return
+			}
+			if value != "" && filePath != "" {
+				fmt.Println("✗ Cannot use both --value and --file")
+				return
+			}
+
+			if filePath != "" {
+				plaintext, fileInfo, err = readFile(filePath)
+				if err != nil {
+					fmt.Printf("✗ Failed to read file: %v\n", err)
+					return
+				}
+				fmt.Printf("Read %d bytes from file\n", len(plaintext))
+			} else {
+				plaintext = []byte(value)
+			}
+		default:
+			if value != "" || filePath != "" {
+				fmt.Printf("✗ Secrets of type '%s' are entered field by field, --value and --file are not supported\n", secretType)
+				return
+			}
+
+			record, err := readRecord(cmd, definition, nil)
+			if err != nil {
+				fmt.Printf("✗ %v\n", err)
+				return
+			}
+
+			plaintext, err = json.Marshal(record)
+			if err != nil {
+				fmt.Printf("✗ Failed to encode secret: %v\n", err)
 				return
 			}
-			fmt.Printf("Read %d bytes from file\n", len(dataToEncrypt))
-		} else {
-			dataToEncrypt = []byte(value)
 		}
 
-		encryptedData, err := cryptoService.EncryptData(dataToEncrypt)
+		metadata, err := readMetadata(cmd, nil)
 		if err != nil {
-			fmt.Printf("✗ Encryption failed: %v\n", err)
+			fmt.Printf("✗ %v\n", err)
 			return
 		}
+		if metadata == nil {
+			metadata = &models.ResourceMetadata{}
+		}
+		metadata.File = fileInfo
 
-		resourceID, err := resourceClient.CreateResource(name, secretType, encryptedData)
+		resourceID, err := createSecret(cryptoService, name, secretType, plaintext, metadata, expiry)
 		if err != nil {
-			fmt.Printf("✗ Failed to save secret: %v\n", err)
+			if errors.Is(err, errSecretExists) {
+				fmt.Printf("✗ Secret '%s' already exists. Use 'gophkeeper update' to change it.\n", name)
+				return
+			}
+			fmt.Printf("✗ %v\n", err)
 			return
 		}
 
-		fmt.Printf("✓ Secret '%s' saved (ID: %d, size: %d bytes)\n", name, resourceID, len(dataToEncrypt))
+		fmt.Printf("✓ Secret '%s' saved (ID: %d)\n", name, resourceID)
 	},
 }
 
+// errSecretExists is returned by createSecret if a secret with the same name already exists
+var errSecretExists = errors.New("secret already exists")
+
+// createSecret encrypts and stores a new secret, the real size is recorded in metadata
+// expiry may be nil if the secret neither expires nor needs rotation
+// Returns the ID of the created secret
+func createSecret(cryptoService *crypto.CryptoService, name, secretType string, plaintext []byte,
+	metadata *models.ResourceMetadata, expiry *expirySettings) (int64, error) {
+
+	encryptedData, err := cryptoService.EncryptData(plaintext)
+	if err != nil {
+		return 0, fmt.Errorf("encryption failed: %w", err)
+	}
+
+	metadata.Size = int64(len(plaintext))
+	encryptedMetadata, err := encryptMetadata(cryptoService, metadata)
+	if err != nil {
+		return 0, fmt.Errorf("encryption failed: %w", err)
+	}
+
+	nameIndex, encryptedName, err := sealName(cryptoService, name)
+	if err != nil {
+		return 0, fmt.Errorf("invalid name: %w", err)
+	}
+	// Secrets stored before names were encrypted are only known by their plaintext name
+	exists, err := secretExists(cryptoService, name)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check the name: %w", err)
+	}
+	if exists {
+		return 0, errSecretExists
+	}
+
+	if expiry == nil {
+		expiry = &expirySettings{}
+	}
+	resourceID, err := resourceClient.CreateResource(nameIndex, secretType, encryptedName, encryptedData, encryptedMetadata,
+		expiry.ExpiresAt, expiry.RotateEvery)
+	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return 0, errSecretExists
+		}
+		return 0, fmt.Errorf("failed to save secret: %w", err)
+	}
+	return resourceID, nil
+}
+
 func init() {
 	rootCmd.AddCommand(setCmd)
-	setCmd.Flags().StringP("name", "n", "", "Name of the secret")
+	setCmd.Flags().StringP("name", "n", "", "Name of the secret, may contain folders (e.g. prod/db/password)")
 	setCmd.Flags().StringP("value", "v", "", "Value to store (for small data)")
 	setCmd.Flags().StringP("file", "f", "", "Path to file (for large data)")
-	setCmd.Flags().StringP("type", "t", "", "Type: credentials | text | binary | card")
+	setCmd.Flags().StringP("type", "t", "", "Type: credentials | text | binary | card | ssh_key | totp or a type from 'gophkeeper types'")
+	addCredentialFlags(setCmd)
+	setCmd.Flags().Bool("generate", false, "Generate the password instead of prompting for it (credentials only)")
+	addGeneratorFlags(setCmd)
+	addCardFlags(setCmd)
+	addSSHKeyFlags(setCmd)
+	addTOTPFlags(setCmd)
+	addMetadataFlags(setCmd)
+	addExpiryFlags(setCmd)
 	setCmd.MarkFlagRequired("name")
 	setCmd.MarkFlagRequired("type")
 }
@@ -0,0 +1,219 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/agent"
+	"google.golang.org/protobuf/proto"
+)
+
+// sshAgentCmd represents the ssh-agent command
+var sshAgentCmd = &cobra.Command{
+	Use:   "ssh-agent",
+	Short: "Serve stored SSH keys over the ssh-agent protocol",
+	Long: `Serve stored SSH keys to ssh and git over the ssh-agent protocol.
+
+The keys are decrypted once on start and kept in memory only, they are never
+written to disk. The agent listens on a Unix socket readable by the current
+user only and runs until interrupted.
+
+Examples:
+  gophkeeper ssh-agent
+  gophkeeper ssh-agent -n keys/github --socket /tmp/gophkeeper.sock
+  gophkeeper ssh-agent --tag work
+
+  # In another shell
+  export SSH_AUTH_SOCK=~/.gophkeeper/agent.sock
+  ssh-add -l`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		names, _ := cmd.Flags().GetStringArray("name")
+		tags, _ := cmd.Flags().GetStringArray("tag")
+		socketPath, _ := cmd.Flags().GetString("socket")
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		if socketPath == "" {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				fmt.Printf("✗ Failed to get home directory: %v\n", err)
+				return
+			}
+			socketPath = filepath.Join(home, ".gophkeeper", "agent.sock")
+		}
+
+		keyring := agent.NewKeyring()
+		loaded, err := loadAgentKeys(cryptoService, keyring, names, tags)
+		if err != nil {
+			fmt.Printf("✗ %v\n", err)
+			return
+		}
+		if loaded == 0 {
+			fmt.Println("✗ No SSH keys found")
+			return
+		}
+
+		listener, err := listenAgentSocket(socketPath)
+		if err != nil {
+			fmt.Printf("✗ Failed to listen on %s: %v\n", socketPath, err)
+			return
+		}
+		defer os.Remove(socketPath)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		go func() {
+			<-ctx.Done()
+			listener.Close()
+		}()
+
+		fmt.Fprintf(os.Stderr, "✓ Serving %d SSH key(s), press Ctrl+C to stop\n", loaded)
+		fmt.Printf("SSH_AUTH_SOCK=%s; export SSH_AUTH_SOCK;\n", socketPath)
+
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				if ctx.Err() == nil {
+					fmt.Fprintf(os.Stderr, "✗ Failed to accept connection: %v\n", err)
+				}
+				return
+			}
+			go func() {
+				defer conn.Close()
+				agent.ServeAgent(keyring, conn)
+			}()
+		}
+	},
+}
+
+// loadAgentKeys decrypts stored SSH keys and adds them to the keyring
+// Only keys with the given names and all the given tags are loaded, all keys if both are empty
+// Returns the number of loaded keys
+func loadAgentKeys(cryptoService *crypto.CryptoService, keyring agent.Agent, names, tags []string) (int, error) {
+	resources, err := resourceClient.ListAllResources(&pb.ListResourcesRequest{Type: proto.String(string(models.TypeSSHKey))})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list SSH keys: %w", err)
+	}
+	revealNames(cryptoService, resources)
+
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		cleaned, err := models.CleanResourceName(name)
+		if err != nil {
+			return 0, fmt.Errorf("invalid name '%s': %w", name, err)
+		}
+		wanted[cleaned] = true
+	}
+
+	loaded := 0
+	for _, r := range resources {
+		if len(wanted) > 0 && !wanted[r.GetName()] {
+			continue
+		}
+		if len(tags) > 0 {
+			metadata, err := decryptMetadata(cryptoService, r.GetMetadata())
+			if err != nil || !hasAllTags(metadata, tags) {
+				continue
+			}
+		}
+
+		resource, err := resourceClient.GetResource(r.GetId())
+		if err != nil {
+			return loaded, fmt.Errorf("failed to get SSH key '%s': %w", r.GetName(), err)
+		}
+		key, err := decryptSSHKey(cryptoService, resource.GetData())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠ Skipping '%s': %v\n", r.GetName(), err)
+			continue
+		}
+		privateKey, err := key.RawPrivateKey()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠ Skipping '%s': %v\n", r.GetName(), err)
+			continue
+		}
+
+		comment := key.Comment
+		if comment == "" {
+			comment = r.GetName()
+		}
+		if err := keyring.Add(agent.AddedKey{PrivateKey: privateKey, Comment: comment}); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠ Skipping '%s': %v\n", r.GetName(), err)
+			continue
+		}
+		loaded++
+	}
+
+	return loaded, nil
+}
+
+// listenAgentSocket listens on a Unix socket accessible by the current user only
+// A socket left by an agent that was not stopped cleanly is replaced
+func listenAgentSocket(path string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a socket", path)
+		}
+		if conn, err := net.Dial("unix", path); err == nil {
+			conn.Close()
+			return nil, errors.New("another agent is already listening")
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+
+	// The socket is created in a fresh directory only the current user can enter,
+	// restricted to the owner and only then moved to path, so nobody can connect in between
+	dir, err := os.MkdirTemp(filepath.Dir(path), ".agent-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	tmpPath := filepath.Join(dir, "agent.sock")
+	listener, err := net.Listen("unix", tmpPath)
+	if err != nil {
+		return nil, err
+	}
+	// The listener would remove tmpPath on close, the socket is at path by then
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+
+	if err := os.Chmod(tmpPath, 0600); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
+
+func init() {
+	rootCmd.AddCommand(sshAgentCmd)
+	sshAgentCmd.Flags().StringArrayP("name", "n", nil, "Serve only the SSH key with this name, may be repeated")
+	sshAgentCmd.Flags().StringArray("tag", nil, "Serve only SSH keys with this tag, may be repeated")
+	sshAgentCmd.Flags().String("socket", "", "Path to the agent socket (default ~/.gophkeeper/agent.sock)")
+}
@@ -0,0 +1,85 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+)
+
+// addSSHKeyFlags registers flags for the non-secret fields of an SSH key
+func addSSHKeyFlags(cmd *cobra.Command) {
+	cmd.Flags().String("comment", "", "Comment of the key, e.g. user@host (ssh_key only)")
+}
+
+// readSSHKey builds an SSH key from a PEM private key, prompting for its passphrase if it is protected
+// If privateKey is nil, the key of current is kept and only the comment is changed
+func readSSHKey(cmd *cobra.Command, privateKey []byte, current *models.SSHKey) (*models.SSHKey, error) {
+	comment, _ := cmd.Flags().GetString("comment")
+
+	if privateKey == nil {
+		if current == nil {
+			return nil, errors.New("either --value or --file with a private key must be provided")
+		}
+		key := *current
+		if comment != "" {
+			key.Comment = comment
+		}
+		return &key, nil
+	}
+
+	if comment == "" && current != nil {
+		comment = current.Comment
+	}
+
+	key, err := models.NewSSHKey(privateKey, "", comment)
+	if errors.Is(err, models.ErrSSHPassphrase) {
+		passphrase, promptErr := promptPassword("Passphrase of the SSH key: ")
+		if promptErr != nil {
+			return nil, promptErr
+		}
+		key, err = models.NewSSHKey(privateKey, passphrase, comment)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// decryptSSHKey decrypts an ssh_key resource payload
+func decryptSSHKey(cryptoService *crypto.CryptoService, data []byte) (*models.SSHKey, error) {
+	var key models.SSHKey
+	if err := cryptoService.DecryptJSON(data, &key); err != nil {
+		return nil, err
+	}
+	if key.PrivateKey == "" {
+		return nil, models.ErrInvalidSSHKey
+	}
+	return &key, nil
+}
+
+// printSSHKey prints an SSH key, the private key and passphrase are shown only if reveal is set
+func printSSHKey(key *models.SSHKey, reveal bool) {
+	fmt.Printf("Public key: %s\n", key.AuthorizedKey())
+	fmt.Printf("Fingerprint: %s\n", key.Fingerprint())
+	if key.Comment != "" {
+		fmt.Printf("Comment: %s\n", key.Comment)
+	}
+	if key.Passphrase != "" {
+		passphrase := maskSecret(key.Passphrase)
+		if reveal {
+			passphrase = key.Passphrase
+		}
+		fmt.Printf("Passphrase: %s\n", passphrase)
+	}
+	if reveal {
+		fmt.Printf("Private key:\n%s", key.PrivateKey)
+		return
+	}
+	fmt.Println("Private key: hidden, use --reveal, --field private_key or --out to get it")
+}
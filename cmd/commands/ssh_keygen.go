@@ -0,0 +1,104 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+)
+
+// sshKeygenCmd represents the ssh-keygen command
+var sshKeygenCmd = &cobra.Command{
+	Use:   "ssh-keygen",
+	Short: "Generate an SSH key and store it as a secret",
+	Long: `Generate an SSH key pair and store it as an ssh_key secret.
+The private key never touches the disk, the public key is printed.
+
+Examples:
+  gophkeeper ssh-keygen -n keys/github -C octocat@laptop
+  gophkeeper ssh-keygen -n keys/legacy -t rsa -b 4096 --passphrase
+  gophkeeper get keys/github --field public_key`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		name, _ := cmd.Flags().GetString("name")
+		keyType, _ := cmd.Flags().GetString("type")
+		bits, _ := cmd.Flags().GetInt("bits")
+		comment, _ := cmd.Flags().GetString("comment")
+		withPassphrase, _ := cmd.Flags().GetBool("passphrase")
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		passphrase := ""
+		if withPassphrase {
+			passphrase, err = promptPassword("Passphrase of the SSH key: ")
+			if err != nil {
+				fmt.Printf("✗ %v\n", err)
+				return
+			}
+			confirm, err := promptPassword("Confirm passphrase: ")
+			if err != nil {
+				fmt.Printf("✗ %v\n", err)
+				return
+			}
+			if passphrase != confirm {
+				fmt.Println("✗ Passphrases do not match")
+				return
+			}
+		}
+
+		key, err := models.GenerateSSHKey(keyType, bits, comment, passphrase)
+		if err != nil {
+			fmt.Printf("✗ Failed to generate SSH key: %v\n", err)
+			return
+		}
+
+		plaintext, err := json.Marshal(key)
+		if err != nil {
+			fmt.Printf("✗ Failed to encode SSH key: %v\n", err)
+			return
+		}
+
+		metadata, err := readMetadata(cmd, nil)
+		if err != nil {
+			fmt.Printf("✗ %v\n", err)
+			return
+		}
+		if metadata == nil {
+			metadata = &models.ResourceMetadata{}
+		}
+
+		resourceID, err := createSecret(cryptoService, name, string(models.TypeSSHKey), plaintext, metadata, nil)
+		if err != nil {
+			if errors.Is(err, errSecretExists) {
+				fmt.Printf("✗ Secret '%s' already exists\n", name)
+				return
+			}
+			fmt.Printf("✗ %v\n", err)
+			return
+		}
+
+		fmt.Printf("✓ SSH key '%s' saved (ID: %d)\n", name, resourceID)
+		fmt.Printf("Fingerprint: %s\n", key.Fingerprint())
+		fmt.Println(key.AuthorizedKey())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(sshKeygenCmd)
+	sshKeygenCmd.Flags().StringP("name", "n", "", "Name of the secret to store the key in")
+	sshKeygenCmd.Flags().StringP("type", "t", "ed25519", "Key type: ed25519 | rsa | ecdsa")
+	sshKeygenCmd.Flags().IntP("bits", "b", 0, "Key size for rsa (default 4096) and ecdsa (default 256) keys")
+	sshKeygenCmd.Flags().StringP("comment", "C", "", "Comment of the key, e.g. user@host")
+	sshKeygenCmd.Flags().Bool("passphrase", false, "Protect the private key with a passphrase (prompted)")
+	addMetadataFlags(sshKeygenCmd)
+	sshKeygenCmd.MarkFlagRequired("name")
+}
@@ -0,0 +1,109 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+)
+
+// addTOTPFlags registers flags for the parameters of a TOTP seed
+// The issuer is set with the --issuer flag registered by addCardFlags
+func addTOTPFlags(cmd *cobra.Command) {
+	cmd.Flags().String("account", "", "Account name, e.g. user@example.com (totp only)")
+	cmd.Flags().String("algorithm", "", "Hash algorithm: SHA1 | SHA256 | SHA512 (totp only, default SHA1)")
+	cmd.Flags().Int("digits", 0, "Number of digits: 6 | 8 (totp only, default 6)")
+	cmd.Flags().Int("period", 0, "Code lifetime in seconds (totp only, default 30)")
+}
+
+// readTOTP builds a TOTP seed from an otpauth URI or a base32 secret and applies flags to it
+// If input is empty, the seed of current is kept, or the URI or secret is prompted for if current is nil
+func readTOTP(cmd *cobra.Command, input string, current *models.TOTP) (*models.TOTP, error) {
+	if input == "" && current == nil {
+		var err error
+		input, err = promptPassword("otpauth URI or base32 secret: ")
+		if err != nil {
+			return nil, err
+		}
+		if input == "" {
+			return nil, models.ErrInvalidTOTPSecret
+		}
+	}
+
+	totp := &models.TOTP{}
+	switch {
+	case strings.HasPrefix(strings.ToLower(strings.TrimSpace(input)), "otpauth:"):
+		parsed, err := models.ParseOTPAuthURI(input)
+		if err != nil {
+			return nil, err
+		}
+		totp = parsed
+	case input != "":
+		totp = models.NewTOTP(input)
+		if current != nil {
+			totp.Issuer, totp.Account = current.Issuer, current.Account
+		}
+	default:
+		*totp = *current
+	}
+
+	if issuer, _ := cmd.Flags().GetString("issuer"); issuer != "" {
+		totp.Issuer = issuer
+	}
+	if account, _ := cmd.Flags().GetString("account"); account != "" {
+		totp.Account = account
+	}
+	if algorithm, _ := cmd.Flags().GetString("algorithm"); algorithm != "" {
+		totp.Algorithm = strings.ToUpper(algorithm)
+	}
+	if cmd.Flags().Changed("digits") {
+		totp.Digits, _ = cmd.Flags().GetInt("digits")
+	}
+	if cmd.Flags().Changed("period") {
+		totp.Period, _ = cmd.Flags().GetInt("period")
+	}
+
+	if err := totp.Validate(); err != nil {
+		return nil, err
+	}
+	return totp, nil
+}
+
+// decryptTOTP decrypts a totp resource payload
+func decryptTOTP(cryptoService *crypto.CryptoService, data []byte) (*models.TOTP, error) {
+	var totp models.TOTP
+	if err := cryptoService.DecryptJSON(data, &totp); err != nil {
+		return nil, err
+	}
+	if err := totp.Validate(); err != nil {
+		return nil, err
+	}
+	return &totp, nil
+}
+
+// printTOTP prints the parameters and the current code of a TOTP seed, the secret is masked unless reveal is set
+func printTOTP(totp *models.TOTP, reveal bool) {
+	if totp.Issuer != "" {
+		fmt.Printf("Issuer: %s\n", totp.Issuer)
+	}
+	if totp.Account != "" {
+		fmt.Printf("Account: %s\n", totp.Account)
+	}
+	secret := maskSecret(totp.Secret)
+	if reveal {
+		secret = totp.Secret
+	}
+	fmt.Printf("Secret: %s\n", secret)
+	fmt.Printf("Algorithm: %s, %d digits, %ds period\n", totp.Algorithm, totp.Digits, totp.Period)
+
+	now := time.Now()
+	if code, err := totp.Code(now); err == nil {
+		fmt.Printf("Code: %s (%ds remaining)\n", code, int(totp.Remaining(now).Seconds()))
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/spf13/cobra"
+)
+
+// trashCmd represents the trash command
+var trashCmd = &cobra.Command{
+	Use:   "trash",
+	Short: "Manage deleted secrets",
+	Long: `Deleted secrets are kept in trash until they are restored, emptied
+or purged automatically by the server after the retention period.
+
+Examples:
+  gophkeeper trash list
+  gophkeeper trash restore <name>
+  gophkeeper trash restore --id 42
+  gophkeeper trash empty`,
+}
+
+// trashListCmd represents the trash list command
+var trashListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List deleted secrets",
+	Long:  `gophkeeper trash list`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		response, err := resourceClient.ListTrash()
+		if err != nil {
+			fmt.Printf("✗ Failed to list trash: %v\n", err)
+			return
+		}
+
+		if len(response.GetResources()) == 0 {
+			fmt.Println("Trash is empty.")
+			return
+		}
+
+		for _, r := range response.GetResources() {
+			fmt.Printf("%-6d %-30s %-12s deleted at %s\n", r.GetId(), displayName(cryptoService, r), r.GetType(), r.GetDeletedAt())
+		}
+	},
+}
+
+// trashRestoreCmd represents the trash restore command
+var trashRestoreCmd = &cobra.Command{
+	Use:   "restore [name]",
+	Short: "Restore a deleted secret by name or ID",
+	Long: `Restore a deleted secret by name or ID.
+
+Trash may hold several deleted secrets with the same name. They are listed
+with their IDs and one of them is restored with --id.
+
+Examples:
+  gophkeeper trash restore <name>
+  gophkeeper trash restore --id 42`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		id, _ := cmd.Flags().GetInt64("id")
+		if len(args) == 0 && id == 0 {
+			fmt.Println("✗ Specify a name or --id")
+			return
+		}
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		response, err := resourceClient.ListTrash()
+		if err != nil {
+			fmt.Printf("✗ Failed to list trash: %v\n", err)
+			return
+		}
+
+		var candidates []*pb.GetResourceResponse
+		for _, r := range response.GetResources() {
+			if id != 0 && r.GetId() != id {
+				continue
+			}
+			if len(args) == 1 && displayName(cryptoService, r) != args[0] {
+				continue
+			}
+			candidates = append(candidates, r)
+		}
+
+		switch {
+		case len(candidates) == 0 && id != 0:
+			fmt.Printf("✗ Secret #%d not found in trash\n", id)
+			return
+		case len(candidates) == 0:
+			fmt.Printf("✗ Secret '%s' not found in trash\n", args[0])
+			return
+		case len(candidates) > 1:
+			fmt.Printf("✗ Trash holds %d secrets named '%s':\n", len(candidates), args[0])
+			for _, r := range candidates {
+				fmt.Printf("  %-6d %-12s deleted at %s\n", r.GetId(), r.GetType(), r.GetDeletedAt())
+			}
+			fmt.Println("Choose one with --id")
+			return
+		}
+
+		name := displayName(cryptoService, candidates[0])
+		if err := resourceClient.RestoreResource(candidates[0].GetId()); err != nil {
+			fmt.Printf("✗ Failed to restore secret: %v\n", err)
+			return
+		}
+		if len(candidates[0].GetEncryptedName()) == 0 {
+			// A secret deleted before its name was migrated brings back a plaintext name
+			tokenStore.SetNamesMigrated(false)
+			fmt.Println("⚠ This secret has a plaintext name, run 'gophkeeper migrate-names' to encrypt it")
+		}
+
+		fmt.Printf("✓ Secret '%s' restored successfully\n", name)
+	},
+}
+
+// trashEmptyCmd represents the trash empty command
+var trashEmptyCmd = &cobra.Command{
+	Use:   "empty",
+	Short: "Permanently delete all secrets in trash",
+	Long:  `gophkeeper trash empty`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		// Names are only needed for error messages, so emptying works while locked
+		cryptoService, _ := masterKeyStore.GetCryptoService()
+
+		response, err := resourceClient.ListTrash()
+		if err != nil {
+			fmt.Printf("✗ Failed to list trash: %v\n", err)
+			return
+		}
+
+		purged := 0
+		for _, r := range response.GetResources() {
+			if err := resourceClient.PurgeResource(r.GetId()); err != nil {
+				fmt.Printf("✗ Failed to purge secret '%s': %v\n", displayName(cryptoService, r), err)
+				continue
+			}
+			purged++
+		}
+
+		fmt.Printf("✓ %d secret(s) permanently deleted\n", purged)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(trashCmd)
+	trashCmd.AddCommand(trashListCmd)
+	trashCmd.AddCommand(trashRestoreCmd)
+	trashRestoreCmd.Flags().Int64("id", 0, "ID of the secret to restore, as shown by 'trash list'")
+	trashCmd.AddCommand(trashEmptyCmd)
+}
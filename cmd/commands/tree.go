@@ -0,0 +1,133 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+)
+
+// treeCmd represents the tree command
+var treeCmd = &cobra.Command{
+	Use:   "tree [folder]",
+	Short: "Show secrets as a folder tree",
+	Long: `Show secrets as a folder tree.
+
+Examples:
+  gophkeeper tree
+  gophkeeper tree prod`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		folder := ""
+		if len(args) == 1 {
+			folder = strings.Trim(args[0], models.PathSeparator)
+		}
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		resources, err := resourceClient.ListAllResources(&pb.ListResourcesRequest{})
+		if err != nil {
+			fmt.Printf("✗ Failed to list secrets: %v\n", err)
+			return
+		}
+		revealNames(cryptoService, resources)
+
+		inFolder := resources[:0]
+		for _, r := range resources {
+			if models.IsInFolder(r.GetName(), folder) {
+				inFolder = append(inFolder, r)
+			}
+		}
+
+		printResourceTree(inFolder, folder)
+	},
+}
+
+// printResourceTree prints resources located in folder as a tree
+func printResourceTree(resources []*pb.GetResourceResponse, folder string) {
+	if len(resources) == 0 {
+		fmt.Println("No secrets found.")
+		return
+	}
+
+	root := folder
+	if root == "" {
+		root = "."
+	}
+	fmt.Println(root)
+	printTree(buildTree(resources, folder), "")
+}
+
+// treeNode is a folder or a secret in the tree view
+type treeNode struct {
+	name     string
+	resource *pb.GetResourceResponse
+	children map[string]*treeNode
+}
+
+// buildTree groups resources by path segments relative to folder
+func buildTree(resources []*pb.GetResourceResponse, folder string) *treeNode {
+	root := &treeNode{children: map[string]*treeNode{}}
+
+	for _, r := range resources {
+		relative := r.GetName()
+		if folder != "" {
+			relative = strings.TrimPrefix(relative, folder+models.PathSeparator)
+		}
+
+		node := root
+		for _, segment := range strings.Split(relative, models.PathSeparator) {
+			child, ok := node.children[segment]
+			if !ok {
+				child = &treeNode{name: segment, children: map[string]*treeNode{}}
+				node.children[segment] = child
+			}
+			node = child
+		}
+		node.resource = r
+	}
+
+	return root
+}
+
+func printTree(node *treeNode, indent string) {
+	names := make([]string, 0, len(node.children))
+	for name := range node.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		child := node.children[name]
+
+		branch, nextIndent := "├── ", indent+"│   "
+		if i == len(names)-1 {
+			branch, nextIndent = "└── ", indent+"    "
+		}
+
+		label := child.name
+		if len(child.children) > 0 {
+			label += models.PathSeparator
+		}
+		if child.resource != nil {
+			label += fmt.Sprintf(" (%s)", child.resource.GetType())
+		}
+		fmt.Println(indent + branch + label)
+
+		printTree(child, nextIndent)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(treeCmd)
+}
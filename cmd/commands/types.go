@@ -0,0 +1,279 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// typesCmd represents the types command
+var typesCmd = &cobra.Command{
+	Use:   "types",
+	Short: "List and define secret types",
+	Long: `List the built-in secret types and the types defined for your account.
+
+Secrets of a defined type are entered and shown field by field: 'set' prompts
+for every field, secret fields are hidden on input and masked by 'get'.
+
+Examples:
+  gophkeeper types
+  gophkeeper types -o json
+  gophkeeper types define wifi --field ssid:string:required --field password:string:secret:required
+  gophkeeper types define license -f license-type.json
+  gophkeeper types delete wifi`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		output, _ := cmd.Flags().GetString("output")
+
+		definitions, err := listTypes()
+		if err != nil {
+			fmt.Printf("✗ Failed to list types: %v\n", err)
+			return
+		}
+
+		switch output {
+		case "json":
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(definitions); err != nil {
+				fmt.Fprintf(os.Stderr, "✗ Failed to encode JSON: %v\n", err)
+			}
+		case "table":
+			printTypes(definitions)
+		default:
+			fmt.Println("✗ Invalid output format. Use: table or json")
+		}
+	},
+}
+
+// typesDefineCmd represents the types define command
+var typesDefineCmd = &cobra.Command{
+	Use:   "define <name>",
+	Short: "Define a secret type for your account",
+	Long: `Define a secret type for your account.
+
+Fields are given as name:kind[:secret][:required], kinds are
+string, text, number, boolean, date (YYYY-MM-DD) and url.
+A JSON file with the same structure as 'gophkeeper types -o json' may be given instead.
+
+Examples:
+  gophkeeper types define wifi --field ssid:string:required --field password:string:secret:required
+  gophkeeper types define license -f license-type.json`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		fields, _ := cmd.Flags().GetStringArray("field")
+		description, _ := cmd.Flags().GetString("description")
+		filePath, _ := cmd.Flags().GetString("file")
+
+		definition := &models.TypeDefinition{}
+		if filePath != "" {
+			data, err := os.ReadFile(filePath)
+			if err != nil {
+				fmt.Printf("✗ Failed to read file: %v\n", err)
+				return
+			}
+			if err := json.Unmarshal(data, definition); err != nil {
+				fmt.Printf("✗ Invalid type definition: %v\n", err)
+				return
+			}
+		}
+		if len(args) == 1 {
+			definition.Name = args[0]
+		}
+		if description != "" {
+			definition.Description = description
+		}
+		for _, spec := range fields {
+			field, err := parseFieldSpec(spec)
+			if err != nil {
+				fmt.Printf("✗ %v\n", err)
+				return
+			}
+			definition.Fields = append(definition.Fields, field)
+		}
+
+		if err := definition.Validate(); err != nil {
+			fmt.Printf("✗ %v\n", err)
+			return
+		}
+
+		if err := resourceClient.DefineResourceType(toPbTypeDefinition(definition)); err != nil {
+			if status.Code(err) == codes.AlreadyExists {
+				fmt.Printf("✗ Type '%s' already exists\n", definition.Name)
+				return
+			}
+			fmt.Printf("✗ Failed to define type: %v\n", err)
+			return
+		}
+
+		fmt.Printf("✓ Type '%s' defined with %d field(s)\n", definition.Name, len(definition.Fields))
+	},
+}
+
+// typesDeleteCmd represents the types delete command
+var typesDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a secret type defined for your account",
+	Long:  `Delete a secret type. Types with secrets, including secrets in trash, cannot be deleted.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+
+		if err := resourceClient.DeleteResourceType(name); err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+				fmt.Printf("✗ Type '%s' not found\n", name)
+			case codes.FailedPrecondition:
+				fmt.Printf("✗ Type '%s' cannot be deleted: %s\n", name, status.Convert(err).Message())
+			default:
+				fmt.Printf("✗ Failed to delete type: %v\n", err)
+			}
+			return
+		}
+
+		fmt.Printf("✓ Type '%s' deleted\n", name)
+	},
+}
+
+// parseFieldSpec parses a field definition given as name:kind[:secret][:required]
+func parseFieldSpec(spec string) (models.FieldDefinition, error) {
+	parts := strings.Split(spec, ":")
+	field := models.FieldDefinition{Name: parts[0], Kind: models.KindString}
+	if len(parts) > 1 && parts[1] != "" {
+		field.Kind = models.FieldKind(parts[1])
+	}
+	for _, option := range parts[min(len(parts), 2):] {
+		switch option {
+		case "secret":
+			field.Secret = true
+		case "required":
+			field.Required = true
+		default:
+			return field, fmt.Errorf("invalid field %q, expected name:kind[:secret][:required]", spec)
+		}
+	}
+	return field, nil
+}
+
+// listTypes returns the built-in types and the types defined by the user
+func listTypes() ([]*models.TypeDefinition, error) {
+	pbTypes, err := resourceClient.ListResourceTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := make([]*models.TypeDefinition, 0, len(pbTypes))
+	for _, t := range pbTypes {
+		definitions = append(definitions, fromPbTypeDefinition(t))
+	}
+	return definitions, nil
+}
+
+// lookupType returns the definition of a type, the server is asked only for types not built into the client
+func lookupType(name string) (*models.TypeDefinition, error) {
+	for i := range models.BuiltInTypes {
+		if models.BuiltInTypes[i].Name == name {
+			return &models.BuiltInTypes[i], nil
+		}
+	}
+
+	definitions, err := listTypes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list types: %w", err)
+	}
+	for _, d := range definitions {
+		if d.Name == name {
+			return d, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown type '%s', run 'gophkeeper types' to list types", name)
+}
+
+func printTypes(definitions []*models.TypeDefinition) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tORIGIN\tFIELDS\tDESCRIPTION")
+	for _, d := range definitions {
+		origin := "custom"
+		if d.BuiltIn {
+			origin = "built-in"
+		}
+
+		fields := make([]string, 0, len(d.Fields))
+		for _, f := range d.Fields {
+			field := f.Name + ":" + string(f.Kind)
+			if f.Secret {
+				field += ":secret"
+			}
+			if f.Required {
+				field += ":required"
+			}
+			fields = append(fields, field)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", d.Name, origin, strings.Join(fields, " "), d.Description)
+	}
+	w.Flush()
+}
+
+func toPbTypeDefinition(definition *models.TypeDefinition) *pb.ResourceTypeDefinition {
+	fields := make([]*pb.FieldDefinition, 0, len(definition.Fields))
+	for _, f := range definition.Fields {
+		fields = append(fields, &pb.FieldDefinition{
+			Name:        proto.String(f.Name),
+			Label:       proto.String(f.Label),
+			Kind:        proto.String(string(f.Kind)),
+			Secret:      proto.Bool(f.Secret),
+			Required:    proto.Bool(f.Required),
+			Description: proto.String(f.Description),
+		})
+	}
+
+	return &pb.ResourceTypeDefinition{
+		Name:        proto.String(definition.Name),
+		Description: proto.String(definition.Description),
+		Fields:      fields,
+	}
+}
+
+func fromPbTypeDefinition(definition *pb.ResourceTypeDefinition) *models.TypeDefinition {
+	result := &models.TypeDefinition{
+		Name:        definition.GetName(),
+		Description: definition.GetDescription(),
+		BuiltIn:     definition.GetBuiltIn(),
+	}
+	for _, f := range definition.GetFields() {
+		result.Fields = append(result.Fields, models.FieldDefinition{
+			Name:        f.GetName(),
+			Label:       f.GetLabel(),
+			Kind:        models.FieldKind(f.GetKind()),
+			Secret:      f.GetSecret(),
+			Required:    f.GetRequired(),
+			Description: f.GetDescription(),
+		})
+	}
+	return result
+}
+
+func init() {
+	rootCmd.AddCommand(typesCmd)
+	typesCmd.Flags().StringP("output", "o", "table", "Output format: table | json")
+
+	typesCmd.AddCommand(typesDefineCmd)
+	typesDefineCmd.Flags().StringArray("field", nil, "Field as name:kind[:secret][:required], may be repeated")
+	typesDefineCmd.Flags().String("description", "", "Description of the type")
+	typesDefineCmd.Flags().StringP("file", "f", "", "Path to a JSON type definition")
+
+	typesCmd.AddCommand(typesDeleteCmd)
+}
@@ -0,0 +1,422 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/crypto"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// updateCmd represents the update command
+var updateCmd = &cobra.Command{
+	Use:   "update <name>",
+	Short: "Replace the value of an existing secret",
+	Long: `Replace the value of an existing secret.
+
+The update is rejected if the secret was changed since it was read,
+so concurrent edits never silently overwrite each other.
+
+If only --tag, --untag, --meta, --type, --expires or --rotate-every are given,
+the value is left untouched and is not uploaded again. Changing the value
+restarts the rotation interval, for credentials only a new password does:
+editing the username, URLs or notes keeps it running.
+
+Examples:
+  gophkeeper update secret -v "new-password"
+  gophkeeper update bigfile -f /path/to/file
+  gophkeeper update secret -v "new-password" --revision 3
+  gophkeeper update secret -v "new-password" --tag rotated --meta owner=
+  gophkeeper update bigfile --tag archive --untag active
+  gophkeeper update db --rotate-every 30d --expires never
+
+  # Credentials, cards and custom types are prompted for, empty answers keep current values
+  gophkeeper update github --url https://github.com/login`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+
+		cryptoService, err := masterKeyStore.GetCryptoService()
+		if err != nil {
+			fmt.Println("✗ Secrets are locked. Run 'gophkeeper unlock' first.")
+			return
+		}
+
+		value, _ := cmd.Flags().GetString("value")
+		filePath, _ := cmd.Flags().GetString("file")
+
+		resource, err := findResource(cryptoService, name)
+		if err != nil {
+			fmt.Printf("✗ Secret '%s' not found: %v\n", name, err)
+			return
+		}
+
+		expectedRevision := resource.GetRevision()
+		if cmd.Flags().Changed("revision") {
+			expectedRevision, _ = cmd.Flags().GetInt64("revision")
+		}
+
+		secretType := resource.GetType()
+		if cmd.Flags().Changed("type") {
+			secretType, _ = cmd.Flags().GetString("type")
+		}
+		definition, err := lookupType(secretType)
+		if err != nil {
+			fmt.Printf("✗ %v\n", err)
+			return
+		}
+
+		expiry, err := readExpiry(cmd)
+		if err != nil {
+			fmt.Printf("✗ %v\n", err)
+			return
+		}
+
+		attributesChanged := cmd.Flags().Changed("type") || cmd.Flags().Changed("tag") ||
+			cmd.Flags().Changed("untag") || cmd.Flags().Changed("meta") || expiry.changed()
+		if value == "" && filePath == "" && attributesChanged && !valueFlagsChanged(cmd) {
+			updateAttributes(cmd, cryptoService, resource, expectedRevision, secretType, expiry)
+			return
+		}
+
+		var plaintext []byte
+		var fileInfo *models.FileInfo // set only if the value is read from a file
+
+		// A new secret value restarts the rotation interval, for credentials only the password counts
+		rotated := false
+		compareData := true
+
+		switch models.ResourceType(secretType) {
+		case models.TypeCredentials:
+			if value != "" || filePath != "" {
+				fmt.Println("✗ Credentials are entered interactively, --value and --file are not supported")
+				return
+			}
+
+			// Stored before credentials became structured: start from scratch
+			current, _ := decryptCredential(cryptoService, resource.GetData())
+
+			credential, err := readCredential(cmd, current)
+			if err != nil {
+				fmt.Printf("✗ Failed to read credential: %v\n", err)
+				return
+			}
+			rotated = current == nil || credential.Password != current.Password
+			compareData = false
+
+			plaintext, err = json.Marshal(credential)
+			if err != nil {
+				fmt.Printf("✗ Failed to encode credential: %v\n", err)
+				return
+			}
+		case models.TypeCard:
+			if value != "" || filePath != "" {
+				fmt.Println("✗ Cards are entered interactively, --value and --file are not supported")
+				return
+			}
+
+			current, _ := decryptCard(cryptoService, resource.GetData())
+
+			card, err := readCard(cmd, current)
+			if err != nil {
+				fmt.Printf("✗ Invalid card: %v\n", err)
+				return
+			}
+			warnIfExpired(card)
+
+			plaintext, err = json.Marshal(card)
+			if err != nil {
+				fmt.Printf("✗ Failed to encode card: %v\n", err)
+				return
+			}
+		case models.TypeTOTP:
+			if value != "" && filePath != "" {
+				fmt.Println("✗ Cannot use both --value and --file")
+				return
+			}
+
+			input := value
+			if filePath != "" {
+				data, err := os.ReadFile(filePath)
+				if err != nil {
+					fmt.Printf("✗ Failed to read file: %v\n", err)
+					return
+				}
+				input = strings.TrimSpace(string(data))
+			}
+
+			// Without a new URI or secret only the parameters given by flags are changed
+			current, _ := decryptTOTP(cryptoService, resource.GetData())
+			totp, err := readTOTP(cmd, input, current)
+			if err != nil {
+				fmt.Printf("✗ Invalid TOTP secret: %v\n", err)
+				return
+			}
+
+			plaintext, err = json.Marshal(totp)
+			if err != nil {
+				fmt.Printf("✗ Failed to encode TOTP secret: %v\n", err)
+				return
+			}
+		case models.TypeSSHKey:
+			if value != "" && filePath != "" {
+				fmt.Println("✗ Cannot use both --value and --file")
+				return
+			}
+
+			var privateKey []byte
+			if value != "" {
+				privateKey = []byte(value)
+			}
+			if filePath != "" {
+				privateKey, fileInfo, err = readFile(filePath)
+				if err != nil {
+					fmt.Printf("✗ Failed to read file: %v\n", err)
+					return
+				}
+			}
+
+			// Without a new private key only the comment is changed
+			current, _ := decryptSSHKey(cryptoService, resource.GetData())
+			key, err := readSSHKey(cmd, privateKey, current)
+			if err != nil {
+				fmt.Printf("✗ Invalid SSH key: %v\n", err)
+				return
+			}
+
+			plaintext, err = json.Marshal(key)
+			if err != nil {
+				fmt.Printf("✗ Failed to encode SSH key: %v\n", err)
+				return
+			}
+		case models.TypeText, models.TypeBinary:
+			if value == "" && filePath == "" {
+				fmt.Println("✗ Either --value or --file must be provided")
+				return
+			}
+			if value != "" && filePath != "" {
+				fmt.Println("✗ Cannot use both --value and --file")
+				return
+			}
+
+			if filePath != "" {
+				plaintext, fileInfo, err = readFile(filePath)
+				if err != nil {
+					fmt.Printf("✗ Failed to read file: %v\n", err)
+					return
+				}
+			} else {
+				plaintext = []byte(value)
+			}
+		default:
+			if value != "" || filePath != "" {
+				fmt.Printf("✗ Secrets of type '%s' are entered field by field, --value and --file are not supported\n", secretType)
+				return
+			}
+
+			// Changing the type of a secret starts from an empty record
+			var current *models.Record
+			if secretType == resource.GetType() {
+				current, _ = decryptRecord(cryptoService, resource.GetData())
+			}
+			record, err := readRecord(cmd, definition, current)
+			if err != nil {
+				fmt.Printf("✗ %v\n", err)
+				return
+			}
+
+			plaintext, err = json.Marshal(record)
+			if err != nil {
+				fmt.Printf("✗ Failed to encode secret: %v\n", err)
+				return
+			}
+		}
+
+		if compareData {
+			previous, err := cryptoService.DecryptData(resource.GetData())
+			rotated = err != nil || !bytes.Equal(previous, plaintext)
+		}
+
+		encryptedData, err := cryptoService.EncryptData(plaintext)
+		if err != nil {
+			fmt.Printf("✗ Encryption failed: %v\n", err)
+			return
+		}
+
+		// Metadata is always sent, it carries the real size of the new data
+		metadata, err := decryptMetadata(cryptoService, resource.GetMetadata())
+		if err != nil {
+			fmt.Printf("✗ Failed to decrypt metadata: %v\n", err)
+			return
+		}
+		changed, err := readMetadata(cmd, metadata)
+		if err != nil {
+			fmt.Printf("✗ %v\n", err)
+			return
+		}
+		if changed != nil {
+			metadata = changed
+		}
+		metadata.Size = int64(len(plaintext))
+		if value != "" || filePath != "" {
+			metadata.File = fileInfo
+		}
+
+		encryptedMetadata, err := encryptMetadata(cryptoService, metadata)
+		if err != nil {
+			fmt.Printf("✗ Encryption failed: %v\n", err)
+			return
+		}
+
+		req := &pb.UpdateResourceRequest{
+			Id:               proto.Int64(resource.GetId()),
+			Type:             proto.String(secretType),
+			Data:             encryptedData,
+			Metadata:         encryptedMetadata,
+			ExpectedRevision: proto.Int64(expectedRevision),
+			UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"type", "data", "metadata"}},
+		}
+		if rotated {
+			req.UpdateMask.Paths = append(req.UpdateMask.Paths, "rotated")
+		}
+		expiry.apply(req)
+
+		response, err := resourceClient.UpdateResourceFields(req)
+		if err != nil {
+			if isRevisionConflict(err) {
+				printConflict(cryptoService, resource, expectedRevision)
+				return
+			}
+			fmt.Printf("✗ Failed to update secret: %v\n", err)
+			return
+		}
+
+		fmt.Printf("✓ Secret '%s' updated (revision %d → %d)\n", name, expectedRevision, response.GetRevision())
+	},
+}
+
+// updateAttributes changes the type, metadata and expiry of a secret without uploading its value again
+func updateAttributes(cmd *cobra.Command, cryptoService *crypto.CryptoService, resource *pb.GetResourceResponse,
+	expectedRevision int64, secretType string, expiry *expirySettings) {
+
+	req := &pb.UpdateResourceRequest{
+		Id:               proto.Int64(resource.GetId()),
+		ExpectedRevision: proto.Int64(expectedRevision),
+		UpdateMask:       &fieldmaskpb.FieldMask{},
+	}
+
+	if secretType != resource.GetType() {
+		req.Type = proto.String(secretType)
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "type")
+	}
+
+	current, err := decryptMetadata(cryptoService, resource.GetMetadata())
+	if err != nil {
+		fmt.Printf("✗ Failed to decrypt metadata: %v\n", err)
+		return
+	}
+	metadata, err := readMetadata(cmd, current)
+	if err != nil {
+		fmt.Printf("✗ %v\n", err)
+		return
+	}
+	if metadata != nil {
+		req.Metadata, err = encryptMetadata(cryptoService, metadata)
+		if err != nil {
+			fmt.Printf("✗ Encryption failed: %v\n", err)
+			return
+		}
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "metadata")
+	}
+	expiry.apply(req)
+
+	if len(req.UpdateMask.Paths) == 0 {
+		fmt.Println("✓ Nothing to update")
+		return
+	}
+
+	response, err := resourceClient.UpdateResourceFields(req)
+	if err != nil {
+		if isRevisionConflict(err) {
+			printConflict(cryptoService, resource, expectedRevision)
+			return
+		}
+		fmt.Printf("✗ Failed to update secret: %v\n", err)
+		return
+	}
+
+	fmt.Printf("✓ Secret '%s' updated (revision %d → %d)\n", displayName(cryptoService, resource), expectedRevision, response.GetRevision())
+}
+
+// valueFlagsChanged reports whether any flag describing the value of a credential or card was given
+func valueFlagsChanged(cmd *cobra.Command) bool {
+	for _, name := range []string{"username", "url", "notes", "field", "secret-field", "holder", "expiry", "issuer", "comment", "account", "algorithm", "digits", "period"} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRevisionConflict checks if the server rejected an operation because of a stale revision
+func isRevisionConflict(err error) bool {
+	code := status.Code(err)
+	return code == codes.FailedPrecondition || code == codes.Aborted
+}
+
+// printConflict explains what changed on the server since the secret was read
+func printConflict(cryptoService *crypto.CryptoService, seen *pb.GetResourceResponse, expectedRevision int64) {
+	fmt.Printf("✗ Secret '%s' was modified by someone else (expected revision %d)\n", displayName(cryptoService, seen), expectedRevision)
+
+	current, err := resourceClient.GetResource(seen.GetId())
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			fmt.Println("  It has been deleted. See 'gophkeeper trash list'.")
+			return
+		}
+		fmt.Printf("  Failed to fetch the current version: %v\n", err)
+		return
+	}
+
+	fmt.Printf("  Current revision: %d, updated at %s\n", current.GetRevision(), current.GetUpdatedAt())
+	if current.GetName() != seen.GetName() {
+		fmt.Printf("  name: %s → %s\n", displayName(cryptoService, seen), displayName(cryptoService, current))
+	}
+	if current.GetType() != seen.GetType() {
+		fmt.Printf("  type: %s → %s\n", seen.GetType(), current.GetType())
+	}
+	if current.GetSize() != seen.GetSize() {
+		fmt.Printf("  size: %d → %d bytes\n", seen.GetSize(), current.GetSize())
+	}
+	if !bytes.Equal(current.GetData(), seen.GetData()) {
+		fmt.Println("  value: changed")
+	}
+	fmt.Println("Review the current version with 'gophkeeper get' and retry.")
+}
+
+func init() {
+	rootCmd.AddCommand(updateCmd)
+	updateCmd.Flags().StringP("value", "v", "", "New value (for small data)")
+	updateCmd.Flags().StringP("file", "f", "", "Path to file with the new value (for large data)")
+	updateCmd.Flags().Int64("revision", 0, "Expected revision (defaults to the current one)")
+	addCredentialFlags(updateCmd)
+	addCardFlags(updateCmd)
+	addSSHKeyFlags(updateCmd)
+	addTOTPFlags(updateCmd)
+	addMetadataFlags(updateCmd)
+	addExpiryFlags(updateCmd)
+	updateCmd.Flags().StringArray("untag", nil, "Remove a tag, may be repeated")
+	updateCmd.Flags().StringP("type", "t", "", "Change the type: credentials | text | binary | card | ssh_key | totp or a type from 'gophkeeper types'")
+}
@@ -0,0 +1,8 @@
+package main
+
+import cmd "github.com/OvsienkoValeriya/GophKeeper/cmd/commands"
+
+// docker-credential-gophkeeper is run by Docker with the operation as the only argument
+func main() {
+	cmd.ExecuteDockerCredentialHelper()
+}
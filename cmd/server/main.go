@@ -32,9 +32,27 @@ func main() {
 	minioBucket := getEnv("MINIO_BUCKET", "gophkeeper")
 	minioUseSSL := getEnv("MINIO_USE_SSL", "false") == "true"
 
+	trashRetention := getEnv("TRASH_RETENTION", "720h")
+	trashPurgeInterval := getEnv("TRASH_PURGE_INTERVAL", "1h")
+
 	logger.InitDefault()
 	defer logger.Sync()
 
+	trashRetentionDuration, err := time.ParseDuration(trashRetention)
+	if err != nil {
+		logger.Sugar.Fatalf("Invalid TRASH_RETENTION %q: %v", trashRetention, err)
+	}
+	if trashRetentionDuration < 0 {
+		logger.Sugar.Fatalf("Invalid TRASH_RETENTION %q: must not be negative", trashRetention)
+	}
+	trashPurgeIntervalDuration, err := time.ParseDuration(trashPurgeInterval)
+	if err != nil {
+		logger.Sugar.Fatalf("Invalid TRASH_PURGE_INTERVAL %q: %v", trashPurgeInterval, err)
+	}
+	if trashPurgeIntervalDuration <= 0 {
+		logger.Sugar.Fatalf("Invalid TRASH_PURGE_INTERVAL %q: must be positive", trashPurgeInterval)
+	}
+
 	userStore, err := services.NewPostgresUserStore(databaseURL)
 	if err != nil {
 		logger.Sugar.Fatalf("Failed to connect to database: %v", err)
@@ -81,6 +99,14 @@ func main() {
 
 	wg := sync.WaitGroup{}
 
+	trashPurger := service.NewTrashPurger(resourceService, trashRetentionDuration, trashPurgeIntervalDuration)
+	wg.Add(1)
+	go func(ctx context.Context, wg *sync.WaitGroup) {
+		defer wg.Done()
+		trashPurger.Run(ctx)
+	}(ctx, &wg)
+	logger.Sugar.Infof("Trash purger started (retention %s, interval %s)", trashRetentionDuration, trashPurgeIntervalDuration)
+
 	wg.Add(1)
 	go func(ctx context.Context, wg *sync.WaitGroup, grpcServer *grpc.Server) {
 		defer wg.Done()
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrBreachDatasetNotFound = errors.New("breached passwords dataset not found")
+	ErrInvalidBreachDataset  = errors.New("invalid breached passwords dataset")
+)
+
+const (
+	rangePrefixLength  = 5             // hex characters of the SHA-1 hash in a range file name
+	binaryRecordLength = sha1.Size + 4 // SHA-1 hash and big-endian uint32 count
+)
+
+// BreachDataset looks up passwords in a local copy of the Pwned Passwords dataset
+// The passwords never leave the process, no network access is needed
+type BreachDataset interface {
+	// Count returns how many times the password appeared in breaches, 0 if it was not found
+	Count(password string) (int64, error)
+	Close() error
+}
+
+// DefaultBreachDatasetPath returns the path used when ClientConfig.BreachDatasetPath is empty
+func DefaultBreachDatasetPath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "pwned-passwords"
+	}
+	return filepath.Join(homeDir, ".gophkeeper", "pwned-passwords")
+}
+
+// OpenBreachDataset opens a breached passwords dataset in one of two formats:
+//   - a directory of range files as downloaded by PwnedPasswordsDownloader: files named
+//     after the first 5 hex characters of the SHA-1 hash (e.g. 5BAA6.txt), each line is
+//     the remaining 35 hex characters and the count separated by ':'
+//   - a binary file of records sorted by hash, each record is the 20-byte SHA-1 hash
+//     followed by the count as a big-endian uint32
+//
+// Parameters:
+//   - path: path to the directory or the binary file
+//
+// Returns:
+//   - BreachDataset: dataset to look passwords up in
+//   - error: error if the dataset does not exist or has an unknown format
+func OpenBreachDataset(path string) (BreachDataset, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrBreachDatasetNotFound, path)
+		}
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return &rangeDataset{dir: path}, nil
+	}
+
+	if info.Size()%binaryRecordLength != 0 {
+		return nil, fmt.Errorf("%w: size of %s is not a multiple of %d bytes", ErrInvalidBreachDataset, path, binaryRecordLength)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &binaryDataset{file: file, records: info.Size() / binaryRecordLength}, nil
+}
+
+// rangeDataset is a directory of Pwned Passwords range files
+type rangeDataset struct {
+	dir string
+}
+
+func (d *rangeDataset) Count(password string) (int64, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:rangePrefixLength], hash[rangePrefixLength:]
+
+	file, err := os.Open(filepath.Join(d.dir, prefix+".txt"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, fmt.Errorf("%w: range file %s.txt is missing in %s", ErrInvalidBreachDataset, prefix, d.dir)
+		}
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lineSuffix, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok || !strings.EqualFold(lineSuffix, suffix) {
+			continue
+		}
+		n, err := strconv.ParseInt(count, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: invalid count in %s.txt", ErrInvalidBreachDataset, prefix)
+		}
+		return n, nil
+	}
+	return 0, scanner.Err()
+}
+
+func (d *rangeDataset) Close() error {
+	return nil
+}
+
+// binaryDataset is a file of fixed-size records sorted by hash, searched with binary search
+type binaryDataset struct {
+	file    *os.File
+	records int64
+}
+
+func (d *binaryDataset) Count(password string) (int64, error) {
+	sum := sha1.Sum([]byte(password))
+	record := make([]byte, binaryRecordLength)
+
+	low, high := int64(0), d.records
+	for low < high {
+		mid := low + (high-low)/2
+		if _, err := d.file.ReadAt(record, mid*binaryRecordLength); err != nil && !errors.Is(err, io.EOF) {
+			return 0, err
+		}
+
+		switch cmp := bytes.Compare(record[:sha1.Size], sum[:]); {
+		case cmp == 0:
+			return int64(binary.BigEndian.Uint32(record[sha1.Size:])), nil
+		case cmp < 0:
+			low = mid + 1
+		default:
+			high = mid
+		}
+	}
+	return 0, nil
+}
+
+func (d *binaryDataset) Close() error {
+	return d.file.Close()
+}
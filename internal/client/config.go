@@ -8,10 +8,11 @@ import (
 )
 
 type ClientConfig struct {
-	ServerAddress string        `json:"server_address"`
-	Timeout       time.Duration `json:"timeout"`
-	TLSEnabled    bool          `json:"tls_enabled"`
-	TLSCertPath   string        `json:"tls_cert_path"`
+	ServerAddress     string        `json:"server_address"`
+	Timeout           time.Duration `json:"timeout"`
+	TLSEnabled        bool          `json:"tls_enabled"`
+	TLSCertPath       string        `json:"tls_cert_path"`
+	BreachDatasetPath string        `json:"breach_dataset_path,omitempty"` // Pwned Passwords dataset for breach-check, DefaultBreachDatasetPath if empty
 }
 
 func DefaultConfig() *ClientConfig {
@@ -33,22 +33,33 @@ func (c *ResourceClient) withAuth(ctx context.Context) context.Context {
 
 // CreateResource creates a new resource
 // Parameters:
-//   - name: name of the resource
+//   - name: name of the resource, the blind index of the name if encryptedName is set
 //   - resourceType: type of the resource
+//   - encryptedName: encrypted name of the resource, nil for a plaintext name
 //   - encryptedData: encrypted data of the resource
+//   - encryptedMetadata: encrypted metadata of the resource, may be nil
+//   - expiresAt: when the resource expires, nil if it does not
+//   - rotateEvery: how often the resource should be rotated, 0 for never
 //
 // Returns:
 //   - int64: id of the created resource
 //   - error: error if the resource creation failed
-func (c *ResourceClient) CreateResource(name, resourceType string, encryptedData []byte) (int64, error) {
+func (c *ResourceClient) CreateResource(name, resourceType string, encryptedName, encryptedData, encryptedMetadata []byte,
+	expiresAt *time.Time, rotateEvery time.Duration) (int64, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 	ctx = c.withAuth(ctx)
 
 	req := &pb.CreateResourceRequest{
-		Name: proto.String(name),
-		Type: proto.String(resourceType),
-		Data: encryptedData,
+		Name:          proto.String(name),
+		Type:          proto.String(resourceType),
+		EncryptedName: encryptedName,
+		Data:          encryptedData,
+		Metadata:      encryptedMetadata,
+		RotateEvery:   proto.Int64(int64(rotateEvery / time.Second)),
+	}
+	if expiresAt != nil {
+		req.ExpiresAt = proto.String(expiresAt.UTC().Format(time.RFC3339))
 	}
 
 	res, err := c.service.CreateResource(ctx, req)
@@ -78,6 +89,26 @@ func (c *ResourceClient) GetResourceByName(name string) (*pb.GetResourceResponse
 	return c.service.GetResourceByName(ctx, req)
 }
 
+// GetResourceInfoByName gets a resource by name without its data
+// Parameters:
+//   - name: name of the resource
+//
+// Returns:
+//   - *pb.GetResourceResponse: resource information without data
+//   - error: error if the resource retrieval failed, NotFound if there is no such resource
+func (c *ResourceClient) GetResourceInfoByName(name string) (*pb.GetResourceResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.GetResourceByNameRequest{
+		Name:        proto.String(name),
+		WithoutData: proto.Bool(true),
+	}
+
+	return c.service.GetResourceByName(ctx, req)
+}
+
 // GetResource gets a resource by id
 // Parameters:
 //   - id: id of the resource
@@ -97,38 +128,389 @@ func (c *ResourceClient) GetResource(id int64) (*pb.GetResourceResponse, error)
 	return c.service.GetResource(ctx, req)
 }
 
-// ListResources lists all resources
+// ListResources lists one page of resources
 // Parameters:
-//   - type: type of the resources (credentials, text, binary, card)
+//   - req: filters, sort order and page token (see ListResourcesRequest)
 //
 // Returns:
-//   - *pb.ListResourcesResponse: list of resources
+//   - *pb.ListResourcesResponse: page of resources without data and the next page token
 //   - error: error if the resource listing failed
-func (c *ResourceClient) ListResources() (*pb.ListResourcesResponse, error) {
+func (c *ResourceClient) ListResources(req *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 	ctx = c.withAuth(ctx)
 
-	req := &pb.ListResourcesRequest{}
-
 	return c.service.ListResources(ctx, req)
 }
 
-// DeleteResource deletes a resource by id
+// ListAllResources lists resources following page tokens until the last page
+// Parameters:
+//   - req: filters and sort order, the page token is managed by the method
+//
+// Returns:
+//   - []*pb.GetResourceResponse: all matching resources without data
+//   - error: error if any page listing failed
+func (c *ResourceClient) ListAllResources(req *pb.ListResourcesRequest) ([]*pb.GetResourceResponse, error) {
+	req = proto.CloneOf(req)
+	req.PageToken = nil
+
+	var resources []*pb.GetResourceResponse
+	for {
+		res, err := c.ListResources(req)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, res.GetResources()...)
+
+		if res.GetNextPageToken() == "" {
+			return resources, nil
+		}
+		req.PageToken = proto.String(res.GetNextPageToken())
+	}
+}
+
+// UpdateResource updates a resource by id
 // Parameters:
 //   - id: id of the resource
+//   - expectedRevision: revision the update is based on
+//   - name: name of the resource, the blind index of the name if encryptedName is set
+//   - encryptedName: encrypted name of the resource, nil keeps the current one while the name does not change
+//   - resourceType: type of the resource
+//   - encryptedData: encrypted data of the resource
+//   - encryptedMetadata: encrypted metadata of the resource, nil keeps the current metadata
 //
 // Returns:
-//   - error: error if the resource deletion failed
-func (c *ResourceClient) DeleteResource(id int64) error {
+//   - *pb.UpdateResourceResponse: updated resource information with the new revision
+//   - error: error if the resource update failed, FailedPrecondition or Aborted if the revision is stale
+func (c *ResourceClient) UpdateResource(id, expectedRevision int64, name string, encryptedName []byte, resourceType string,
+	encryptedData, encryptedMetadata []byte) (*pb.UpdateResourceResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.UpdateResourceRequest{
+		Id:               proto.Int64(id),
+		Name:             proto.String(name),
+		EncryptedName:    encryptedName,
+		Type:             proto.String(resourceType),
+		Data:             encryptedData,
+		Metadata:         encryptedMetadata,
+		ExpectedRevision: proto.Int64(expectedRevision),
+	}
+
+	return c.service.UpdateResource(ctx, req)
+}
+
+// UpdateResourceFields changes only the fields of a resource listed in req.UpdateMask
+// Stored data is not uploaded again unless "data" is in the mask, "rotated" with "data" restarts the rotation interval
+// Parameters:
+//   - req: id, expected revision, update mask and new values of the listed fields
+//
+// Returns:
+//   - *pb.UpdateResourceResponse: updated resource information with the new revision
+//   - error: error if the resource update failed, FailedPrecondition or Aborted if the revision is stale
+func (c *ResourceClient) UpdateResourceFields(req *pb.UpdateResourceRequest) (*pb.UpdateResourceResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	return c.service.UpdateResource(ctx, req)
+}
+
+// RenameResource renames (or moves to another folder) a resource by id
+// Parameters:
+//   - id: id of the resource
+//   - expectedRevision: revision the rename is based on
+//   - newName: new name of the resource, the blind index of the name if encryptedName is set
+//   - encryptedName: encrypted new name of the resource, nil for a plaintext name
+//
+// Returns:
+//   - *pb.RenameResourceResponse: renamed resource information with the new revision
+//   - error: error if the resource rename failed
+func (c *ResourceClient) RenameResource(id, expectedRevision int64, newName string, encryptedName []byte) (*pb.RenameResourceResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.RenameResourceRequest{
+		Id:               proto.Int64(id),
+		NewName:          proto.String(newName),
+		EncryptedName:    encryptedName,
+		ExpectedRevision: proto.Int64(expectedRevision),
+	}
+
+	return c.service.RenameResource(ctx, req)
+}
+
+// MoveFolder moves all resources from one folder to another
+// Parameters:
+//   - from: source folder
+//   - to: destination folder, root if empty
+//
+// Returns:
+//   - int64: number of moved resources
+//   - error: error if the move failed
+//
+// Deprecated: the server moves only resources with plaintext names, stored before names were encrypted.
+// Use RenameResource for each resource of the folder
+func (c *ResourceClient) MoveFolder(from, to string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.MoveFolderRequest{
+		From: proto.String(from),
+		To:   proto.String(to),
+	}
+
+	res, err := c.service.MoveFolder(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetMoved(), nil
+}
+
+// DeleteResource moves a resource to trash by id
+// Parameters:
+//   - id: id of the resource
+//   - expectedRevision: revision the deletion is based on
+//
+// Returns:
+//   - error: error if the resource deletion failed, FailedPrecondition or Aborted if the revision is stale
+func (c *ResourceClient) DeleteResource(id, expectedRevision int64) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 	ctx = c.withAuth(ctx)
 
 	req := &pb.DeleteResourceRequest{
-		Id: proto.Int64(id),
+		Id:               proto.Int64(id),
+		ExpectedRevision: proto.Int64(expectedRevision),
 	}
 
 	_, err := c.service.DeleteResource(ctx, req)
 	return err
 }
+
+// ListTrash lists resources moved to trash
+// Returns:
+//   - *pb.ListTrashResponse: list of deleted resources
+//   - error: error if the trash listing failed
+func (c *ResourceClient) ListTrash() (*pb.ListTrashResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.ListTrashRequest{}
+
+	return c.service.ListTrash(ctx, req)
+}
+
+// ListExpiring lists resources that expire or are due for rotation before the given time
+// Parameters:
+//   - before: resources due before this time are listed, time.Now() lists overdue ones
+//
+// Returns:
+//   - []*pb.GetResourceResponse: due resources without data, the earliest due first
+//   - error: error if the listing failed
+func (c *ResourceClient) ListExpiring(before time.Time) ([]*pb.GetResourceResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.ListExpiringRequest{
+		Before: proto.String(before.UTC().Format(time.RFC3339)),
+	}
+
+	res, err := c.service.ListExpiring(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetResources(), nil
+}
+
+// RestoreResource restores a resource from trash by id
+// Parameters:
+//   - id: id of the resource
+//
+// Returns:
+//   - error: error if the resource restoring failed
+func (c *ResourceClient) RestoreResource(id int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.RestoreResourceRequest{
+		Id: proto.Int64(id),
+	}
+
+	_, err := c.service.RestoreResource(ctx, req)
+	return err
+}
+
+// PurgeResource permanently deletes a resource from trash by id
+// Parameters:
+//   - id: id of the resource
+//
+// Returns:
+//   - error: error if the resource purging failed
+func (c *ResourceClient) PurgeResource(id int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.PurgeResourceRequest{
+		Id: proto.Int64(id),
+	}
+
+	_, err := c.service.PurgeResource(ctx, req)
+	return err
+}
+
+// AddAttachment attaches a file to a resource
+// Parameters:
+//   - resourceID: id of the resource
+//   - encryptedName: encrypted file name
+//   - encryptedData: encrypted file contents
+//   - encryptedMetadata: encrypted metadata of the attachment, may be nil
+//
+// Returns:
+//   - int64: id of the created attachment
+//   - error: error if attaching failed
+func (c *ResourceClient) AddAttachment(resourceID int64, encryptedName, encryptedData, encryptedMetadata []byte) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.AddAttachmentRequest{
+		ResourceId:    proto.Int64(resourceID),
+		EncryptedName: encryptedName,
+		Data:          encryptedData,
+		Metadata:      encryptedMetadata,
+	}
+
+	res, err := c.service.AddAttachment(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GetId(), nil
+}
+
+// ListAttachments lists attachments of a resource
+// Parameters:
+//   - resourceID: id of the resource
+//
+// Returns:
+//   - []*pb.Attachment: attachments without data
+//   - error: error if the attachment listing failed
+func (c *ResourceClient) ListAttachments(resourceID int64) ([]*pb.Attachment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.ListAttachmentsRequest{
+		ResourceId: proto.Int64(resourceID),
+	}
+
+	res, err := c.service.ListAttachments(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetAttachments(), nil
+}
+
+// GetAttachment gets an attachment with its data by id
+// Parameters:
+//   - id: id of the attachment
+//
+// Returns:
+//   - *pb.Attachment: attachment with data
+//   - error: error if the attachment retrieval failed
+func (c *ResourceClient) GetAttachment(id int64) (*pb.Attachment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.GetAttachmentRequest{
+		Id: proto.Int64(id),
+	}
+
+	return c.service.GetAttachment(ctx, req)
+}
+
+// DeleteAttachment permanently deletes an attachment by id
+// Parameters:
+//   - id: id of the attachment
+//
+// Returns:
+//   - error: error if the attachment deletion failed
+func (c *ResourceClient) DeleteAttachment(id int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.DeleteAttachmentRequest{
+		Id: proto.Int64(id),
+	}
+
+	_, err := c.service.DeleteAttachment(ctx, req)
+	return err
+}
+
+// ListResourceTypes lists the built-in resource types and the types defined by the user
+// Returns:
+//   - []*pb.ResourceTypeDefinition: type definitions with their fields
+//   - error: error if the type listing failed
+func (c *ResourceClient) ListResourceTypes() ([]*pb.ResourceTypeDefinition, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	res, err := c.service.ListResourceTypes(ctx, &pb.ListResourceTypesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetTypes(), nil
+}
+
+// DefineResourceType defines a new resource type for the user
+// Parameters:
+//   - definition: name, description and fields of the type
+//
+// Returns:
+//   - error: error if the type definition failed
+func (c *ResourceClient) DefineResourceType(definition *pb.ResourceTypeDefinition) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.DefineResourceTypeRequest{
+		Definition: definition,
+	}
+
+	_, err := c.service.DefineResourceType(ctx, req)
+	return err
+}
+
+// DeleteResourceType deletes a resource type defined by the user
+// Parameters:
+//   - name: name of the type
+//
+// Returns:
+//   - error: error if the type deletion failed
+func (c *ResourceClient) DeleteResourceType(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	ctx = c.withAuth(ctx)
+
+	req := &pb.DeleteResourceTypeRequest{
+		Name: proto.String(name),
+	}
+
+	_, err := c.service.DeleteResourceType(ctx, req)
+	return err
+}
@@ -20,6 +20,9 @@ type TokenRecord struct {
 	RefreshToken string    `json:"refresh_token"`
 	ExpiresAt    time.Time `json:"expires_at"`
 	HasMasterKey bool      `json:"has_master_key"`
+	// NamesMigrated is set once the user has no secrets with plaintext names,
+	// so names are never looked up in plaintext again
+	NamesMigrated bool `json:"names_migrated,omitempty"`
 }
 
 type FileTokenStore struct {
@@ -57,11 +60,12 @@ func (s *FileTokenStore) SaveTokensWithUserID(userID uint, accessToken, refreshT
 	existing, _ := s.loadRecord()
 
 	record := TokenRecord{
-		UserID:       userID,
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresAt:    expiresAt,
-		HasMasterKey: existing.UserID == userID && existing.HasMasterKey,
+		UserID:        userID,
+		AccessToken:   accessToken,
+		RefreshToken:  refreshToken,
+		ExpiresAt:     expiresAt,
+		HasMasterKey:  existing.UserID == userID && existing.HasMasterKey,
+		NamesMigrated: existing.UserID == userID && existing.NamesMigrated,
 	}
 
 	data, err := json.MarshalIndent(record, "", "  ")
@@ -94,6 +98,40 @@ func (s *FileTokenStore) SetHasMasterKey(hasMasterKey bool) error {
 	return os.WriteFile(s.filePath, data, 0600)
 }
 
+// SetNamesMigrated sets the flag names_migrated to the file
+// Parameters:
+//   - namesMigrated: flag names_migrated
+//
+// Returns:
+//   - error: error if the flag saving failed
+func (s *FileTokenStore) SetNamesMigrated(namesMigrated bool) error {
+	record, err := s.loadRecord()
+	if err != nil {
+		return err
+	}
+
+	record.NamesMigrated = namesMigrated
+
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.filePath, data, 0600)
+}
+
+// NamesMigrated checks the flag names_migrated from the file
+// Returns:
+//   - bool: true if the user has no secrets with plaintext names, false if unknown
+//   - error: error if the flag retrieval failed
+func (s *FileTokenStore) NamesMigrated() (bool, error) {
+	record, err := s.loadRecord()
+	if err != nil {
+		return false, err
+	}
+	return record.NamesMigrated, nil
+}
+
 // GetUserID gets the user ID from the file
 // Returns:
 //   - uint: user ID
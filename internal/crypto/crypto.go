@@ -59,6 +59,8 @@ func UnlockWithMasterKey(masterPassword string, salt, storedVerifier []byte) ([]
 }
 
 // EncryptData encrypts data of any type
+// The data is padded before encryption (see PaddedSize), so the ciphertext length
+// does not reveal the exact data length
 // Parameters:
 //   - data: data to encrypt
 //
@@ -66,10 +68,11 @@ func UnlockWithMasterKey(masterPassword string, salt, storedVerifier []byte) ([]
 //   - []byte: encrypted data
 //   - error: error if the data encryption failed
 func (s *CryptoService) EncryptData(data []byte) ([]byte, error) {
-	return Encrypt(data, s.derivedKey)
+	return EncryptWithAAD(Pad(data), s.derivedKey, []byte(PaddingContext))
 }
 
 // DecryptData decrypts data
+// Data encrypted before padding was introduced is decrypted as is
 // Parameters:
 //   - encryptedData: encrypted data
 //
@@ -77,7 +80,12 @@ func (s *CryptoService) EncryptData(data []byte) ([]byte, error) {
 //   - []byte: decrypted data
 //   - error: error if the data decryption failed
 func (s *CryptoService) DecryptData(encryptedData []byte) ([]byte, error) {
-	return Decrypt(encryptedData, s.derivedKey)
+	padded, err := DecryptWithAAD(encryptedData, s.derivedKey, []byte(PaddingContext))
+	if err != nil {
+		// Unpadded data is encrypted without the padding context
+		return Decrypt(encryptedData, s.derivedKey)
+	}
+	return Unpad(padded)
 }
 
 // EncryptJSON encrypts a structure serialized to JSON
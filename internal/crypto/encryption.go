@@ -18,6 +18,19 @@ const NonceSize = 12 // 96 bits - standard for AES-GCM
 //   - ciphertext: encrypted data in format nonce + encrypted_data
 //   - error: error if the encryption failed
 func Encrypt(plaintext, key []byte) ([]byte, error) {
+	return EncryptWithAAD(plaintext, key, nil)
+}
+
+// EncryptWithAAD encrypts data using AES-256-GCM and authenticates additional data with it
+// Parameters:
+//   - plaintext: plaintext data to encrypt
+//   - key: encryption key (32 bytes, result of DeriveKey)
+//   - additionalData: data that is not encrypted but must be the same on decryption
+//
+// Returns:
+//   - ciphertext: encrypted data in format nonce + encrypted_data
+//   - error: error if the encryption failed
+func EncryptWithAAD(plaintext, key, additionalData []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cipher: %w", err)
@@ -34,7 +47,7 @@ func Encrypt(plaintext, key []byte) ([]byte, error) {
 		return nil, fmt.Errorf("failed to generate nonce: %w", err)
 	}
 
-	ciphertext := gcm.Seal(nonce, nonce, plaintext, nil)
+	ciphertext := gcm.Seal(nonce, nonce, plaintext, additionalData)
 
 	return ciphertext, nil
 }
@@ -49,6 +62,20 @@ func Encrypt(plaintext, key []byte) ([]byte, error) {
 //   - plaintext: decrypted data
 //   - error: error if the decryption failed (including wrong key)
 func Decrypt(ciphertext, key []byte) ([]byte, error) {
+	return DecryptWithAAD(ciphertext, key, nil)
+}
+
+// DecryptWithAAD decrypts data encrypted by the EncryptWithAAD function
+//
+// Parameters:
+//   - ciphertext: encrypted data (nonce + encrypted_data)
+//   - key: encryption key (32 bytes)
+//   - additionalData: additional data passed to EncryptWithAAD
+//
+// Returns:
+//   - plaintext: decrypted data
+//   - error: error if the decryption failed (including wrong key or additional data)
+func DecryptWithAAD(ciphertext, key, additionalData []byte) ([]byte, error) {
 	if len(ciphertext) < NonceSize+16 {
 		return nil, fmt.Errorf("ciphertext too short")
 	}
@@ -66,7 +93,7 @@ func Decrypt(ciphertext, key []byte) ([]byte, error) {
 	nonce := ciphertext[:NonceSize]
 	encryptedData := ciphertext[NonceSize:]
 
-	plaintext, err := gcm.Open(nil, nonce, encryptedData, nil)
+	plaintext, err := gcm.Open(nil, nonce, encryptedData, additionalData)
 	if err != nil {
 		return nil, fmt.Errorf("decryption failed (wrong key or corrupted data): %w", err)
 	}
@@ -0,0 +1,315 @@
+package crypto
+
+import (
+	"crypto/rand"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+const (
+	lowerChars  = "abcdefghijklmnopqrstuvwxyz"
+	upperChars  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitChars  = "0123456789"
+	symbolChars = "!@#$%^&*()-_=+[]{};:,.<>/?~"
+
+	// AmbiguousChars are easily confused when a password is read or typed by hand
+	AmbiguousChars = "Il1|O0o`'\"S5B8Z2"
+
+	consonants = "bcdfghjklmnprstvz"
+	vowels     = "aeiou"
+
+	DefaultPasswordLength  = 20
+	DefaultPassphraseWords = 6
+	MinPasswordLength      = 4
+	MaxPasswordLength      = 1024
+	MaxPassphraseWords     = 64
+)
+
+var ErrInvalidPolicy = errors.New("invalid password policy")
+
+//go:embed wordlist.txt
+var wordlistData string
+
+// wordlist is the diceware wordlist, 2048 common English words, 11 bits of entropy per word
+var wordlist = strings.Fields(wordlistData)
+
+// PasswordPolicy describes which characters a generated password is made of
+// Every enabled character class is used at least once
+type PasswordPolicy struct {
+	Length           int
+	Lower            bool
+	Upper            bool
+	Digits           bool
+	Symbols          bool
+	ExcludeAmbiguous bool   // leave out characters from AmbiguousChars
+	Exclude          string // characters never used
+}
+
+// DefaultPasswordPolicy returns a policy with all character classes and DefaultPasswordLength
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{Length: DefaultPasswordLength, Lower: true, Upper: true, Digits: true, Symbols: true}
+}
+
+// allowed removes excluded characters from chars
+func (p PasswordPolicy) allowed(chars string) string {
+	excluded := p.Exclude
+	if p.ExcludeAmbiguous {
+		excluded += AmbiguousChars
+	}
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(excluded, r) {
+			return -1
+		}
+		return r
+	}, chars)
+}
+
+// classes returns the enabled character classes with excluded characters removed
+func (p PasswordPolicy) classes() ([]string, error) {
+	var classes []string
+	for _, class := range []struct {
+		enabled bool
+		chars   string
+	}{{p.Lower, lowerChars}, {p.Upper, upperChars}, {p.Digits, digitChars}, {p.Symbols, symbolChars}} {
+		if !class.enabled {
+			continue
+		}
+		chars := p.allowed(class.chars)
+		if chars == "" {
+			return nil, fmt.Errorf("%w: all characters of a class are excluded", ErrInvalidPolicy)
+		}
+		classes = append(classes, chars)
+	}
+
+	if len(classes) == 0 {
+		return nil, fmt.Errorf("%w: at least one character class is required", ErrInvalidPolicy)
+	}
+	return classes, nil
+}
+
+func (p PasswordPolicy) validateLength(classes int) error {
+	if p.Length < MinPasswordLength || p.Length > MaxPasswordLength {
+		return fmt.Errorf("%w: length must be between %d and %d", ErrInvalidPolicy, MinPasswordLength, MaxPasswordLength)
+	}
+	if p.Length < classes {
+		return fmt.Errorf("%w: length is shorter than the number of character classes", ErrInvalidPolicy)
+	}
+	return nil
+}
+
+// GeneratePassword generates a random password using crypto/rand
+//
+// Parameters:
+//   - policy: length and character classes of the password
+//
+// Returns:
+//   - string: password with at least one character of every enabled class
+//   - error: error if the policy is invalid or the random source failed
+func GeneratePassword(policy PasswordPolicy) (string, error) {
+	classes, err := policy.classes()
+	if err != nil {
+		return "", err
+	}
+	if err := policy.validateLength(len(classes)); err != nil {
+		return "", err
+	}
+
+	password := make([]byte, 0, policy.Length)
+	for _, class := range classes {
+		c, err := randomChar(class)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	all := strings.Join(classes, "")
+	for len(password) < policy.Length {
+		c, err := randomChar(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	if err := shuffle(password); err != nil {
+		return "", err
+	}
+	return string(password), nil
+}
+
+// GeneratePronounceable generates a password of alternating consonants and vowels that is
+// easier to remember and type. Enabled upper case letters, digits and symbols are each used
+// once: a random letter is capitalized, a digit and a symbol are inserted at random positions
+//
+// Parameters:
+//   - policy: length and character classes of the password, lower case letters are always used
+//
+// Returns:
+//   - string: password
+//   - error: error if the policy is invalid or the random source failed
+func GeneratePronounceable(policy PasswordPolicy) (string, error) {
+	policy.Lower = true
+	classes, err := policy.classes()
+	if err != nil {
+		return "", err
+	}
+	if err := policy.validateLength(len(classes)); err != nil {
+		return "", err
+	}
+
+	syllable := []string{policy.allowed(consonants), policy.allowed(vowels)}
+	if syllable[0] == "" || syllable[1] == "" {
+		return "", fmt.Errorf("%w: all consonants or vowels are excluded", ErrInvalidPolicy)
+	}
+
+	var extra []string
+	if policy.Digits {
+		extra = append(extra, policy.allowed(digitChars))
+	}
+	if policy.Symbols {
+		extra = append(extra, policy.allowed(symbolChars))
+	}
+
+	password := make([]byte, 0, policy.Length)
+	for i := 0; len(password) < policy.Length-len(extra); i++ {
+		c, err := randomChar(syllable[i%2])
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	if policy.Upper {
+		// Only letters whose upper case is not excluded can be capitalized
+		var candidates []int
+		for i, c := range password {
+			if policy.allowed(strings.ToUpper(string(c))) != "" {
+				candidates = append(candidates, i)
+			}
+		}
+		if len(candidates) == 0 {
+			return "", fmt.Errorf("%w: no letter can be capitalized", ErrInvalidPolicy)
+		}
+		n, err := randomInt(len(candidates))
+		if err != nil {
+			return "", err
+		}
+		password[candidates[n]] -= 'a' - 'A'
+	}
+
+	for _, class := range extra {
+		c, err := randomChar(class)
+		if err != nil {
+			return "", err
+		}
+		i, err := randomInt(len(password) + 1)
+		if err != nil {
+			return "", err
+		}
+		password = append(password[:i], append([]byte{c}, password[i:]...)...)
+	}
+
+	return string(password), nil
+}
+
+// GeneratePassphrase generates a diceware passphrase from the embedded wordlist
+//
+// Parameters:
+//   - words: number of words, 11 bits of entropy each
+//   - separator: string put between the words
+//   - capitalize: capitalize the first letter of every word
+//
+// Returns:
+//   - string: passphrase
+//   - error: error if the number of words is invalid or the random source failed
+func GeneratePassphrase(words int, separator string, capitalize bool) (string, error) {
+	if words < 1 || words > MaxPassphraseWords {
+		return "", fmt.Errorf("%w: number of words must be between 1 and %d", ErrInvalidPolicy, MaxPassphraseWords)
+	}
+
+	chosen := make([]string, words)
+	for i := range chosen {
+		n, err := randomInt(len(wordlist))
+		if err != nil {
+			return "", err
+		}
+		chosen[i] = wordlist[n]
+		if capitalize {
+			chosen[i] = strings.ToUpper(chosen[i][:1]) + chosen[i][1:]
+		}
+	}
+	return strings.Join(chosen, separator), nil
+}
+
+// PasswordEntropy returns the entropy in bits of a password generated by GeneratePassword
+func PasswordEntropy(policy PasswordPolicy) float64 {
+	classes, err := policy.classes()
+	if err != nil {
+		return 0
+	}
+	return float64(policy.Length) * math.Log2(float64(len(strings.Join(classes, ""))))
+}
+
+// PronounceableEntropy returns the approximate entropy in bits of a password generated by GeneratePronounceable
+func PronounceableEntropy(policy PasswordPolicy) float64 {
+	letters := policy.Length
+	var entropy float64
+	for _, class := range []struct {
+		enabled bool
+		chars   string
+	}{{policy.Digits, digitChars}, {policy.Symbols, symbolChars}} {
+		if class.enabled {
+			letters--
+			entropy += math.Log2(float64(len(policy.allowed(class.chars)))) + math.Log2(float64(policy.Length))
+		}
+	}
+	if letters < 1 {
+		return 0
+	}
+	if policy.Upper {
+		entropy += math.Log2(float64(letters))
+	}
+
+	entropy += float64((letters+1)/2) * math.Log2(float64(len(policy.allowed(consonants))))
+	entropy += float64(letters/2) * math.Log2(float64(len(policy.allowed(vowels))))
+	return entropy
+}
+
+// PassphraseEntropy returns the entropy in bits of a passphrase generated by GeneratePassphrase
+func PassphraseEntropy(words int) float64 {
+	return float64(words) * math.Log2(float64(len(wordlist)))
+}
+
+// randomInt returns a uniformly distributed random number in [0, n)
+func randomInt(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read random data: %w", err)
+	}
+	return int(v.Int64()), nil
+}
+
+func randomChar(chars string) (byte, error) {
+	i, err := randomInt(len(chars))
+	if err != nil {
+		return 0, err
+	}
+	return chars[i], nil
+}
+
+// shuffle permutes b in place (Fisher-Yates)
+func shuffle(b []byte) error {
+	for i := len(b) - 1; i > 0; i-- {
+		j, err := randomInt(i + 1)
+		if err != nil {
+			return err
+		}
+		b[i], b[j] = b[j], b[i]
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package crypto
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		required []string // every class must be used at least once
+		allowed  string
+	}{
+		{
+			name:     "default",
+			policy:   DefaultPasswordPolicy(),
+			required: []string{lowerChars, upperChars, digitChars, symbolChars},
+			allowed:  lowerChars + upperChars + digitChars + symbolChars,
+		},
+		{
+			name:     "digits only",
+			policy:   PasswordPolicy{Length: 8, Digits: true},
+			required: []string{digitChars},
+			allowed:  digitChars,
+		},
+		{
+			name:     "every class at minimum length",
+			policy:   PasswordPolicy{Length: MinPasswordLength, Lower: true, Upper: true, Digits: true, Symbols: true},
+			required: []string{lowerChars, upperChars, digitChars, symbolChars},
+			allowed:  lowerChars + upperChars + digitChars + symbolChars,
+		},
+		{
+			name:     "exclude ambiguous",
+			policy:   PasswordPolicy{Length: 64, Lower: true, Upper: true, Digits: true, ExcludeAmbiguous: true},
+			required: []string{"abcdefghijkmnpqrstuvwxyz", "ACDEFGHJKLMNPQRTUVWXY", "34679"},
+			allowed:  "abcdefghijkmnpqrstuvwxyz" + "ACDEFGHJKLMNPQRTUVWXY" + "34679",
+		},
+		{
+			name:     "exclude characters",
+			policy:   PasswordPolicy{Length: 32, Lower: true, Digits: true, Exclude: "abc0123"},
+			required: []string{"defghijklmnopqrstuvwxyz", "456789"},
+			allowed:  "defghijklmnopqrstuvwxyz456789",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Randomness may hide a missing class in a single run
+			for range 50 {
+				password, err := GeneratePassword(tt.policy)
+				if err != nil {
+					t.Fatalf("GeneratePassword() error = %v", err)
+				}
+				if len(password) != tt.policy.Length {
+					t.Fatalf("len(%q) = %d, want %d", password, len(password), tt.policy.Length)
+				}
+				for _, c := range password {
+					if !strings.ContainsRune(tt.allowed, c) {
+						t.Fatalf("%q contains %q, which is not allowed", password, c)
+					}
+				}
+				for _, class := range tt.required {
+					if !strings.ContainsAny(password, class) {
+						t.Fatalf("%q has no character of %q", password, class)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratePasswordInvalidPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy PasswordPolicy
+	}{
+		{name: "no classes", policy: PasswordPolicy{Length: 20}},
+		{name: "too short", policy: PasswordPolicy{Length: MinPasswordLength - 1, Lower: true}},
+		{name: "too long", policy: PasswordPolicy{Length: MaxPasswordLength + 1, Lower: true}},
+		{name: "class fully excluded", policy: PasswordPolicy{Length: 20, Lower: true, Digits: true, Exclude: digitChars}},
+		{name: "class fully ambiguous", policy: PasswordPolicy{Length: 20, Digits: true, Exclude: "34679", ExcludeAmbiguous: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := GeneratePassword(tt.policy); !errors.Is(err, ErrInvalidPolicy) {
+				t.Errorf("GeneratePassword() error = %v, want %v", err, ErrInvalidPolicy)
+			}
+		})
+	}
+}
+
+func TestGeneratePronounceable(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		required []string
+	}{
+		{name: "lower only", policy: PasswordPolicy{Length: 12}, required: []string{lowerChars}},
+		{
+			name:     "all classes",
+			policy:   PasswordPolicy{Length: 16, Upper: true, Digits: true, Symbols: true},
+			required: []string{lowerChars, upperChars, digitChars, symbolChars},
+		},
+		{
+			name:     "exclude ambiguous",
+			policy:   PasswordPolicy{Length: 16, Upper: true, Digits: true, ExcludeAmbiguous: true},
+			required: []string{lowerChars, upperChars, "34679"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 50 {
+				password, err := GeneratePronounceable(tt.policy)
+				if err != nil {
+					t.Fatalf("GeneratePronounceable() error = %v", err)
+				}
+				if len(password) != tt.policy.Length {
+					t.Fatalf("len(%q) = %d, want %d", password, len(password), tt.policy.Length)
+				}
+				for _, class := range tt.required {
+					if !strings.ContainsAny(password, class) {
+						t.Fatalf("%q has no character of %q", password, class)
+					}
+				}
+				if tt.policy.ExcludeAmbiguous && strings.ContainsAny(password, AmbiguousChars) {
+					t.Fatalf("%q contains ambiguous characters", password)
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratePassphrase(t *testing.T) {
+	tests := []struct {
+		name       string
+		words      int
+		separator  string
+		capitalize bool
+	}{
+		{name: "default", words: DefaultPassphraseWords, separator: "-"},
+		{name: "single word", words: 1, separator: " "},
+		{name: "capitalized", words: 4, separator: ".", capitalize: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passphrase, err := GeneratePassphrase(tt.words, tt.separator, tt.capitalize)
+			if err != nil {
+				t.Fatalf("GeneratePassphrase() error = %v", err)
+			}
+			words := strings.Split(passphrase, tt.separator)
+			if len(words) != tt.words {
+				t.Fatalf("%q has %d words, want %d", passphrase, len(words), tt.words)
+			}
+			for _, word := range words {
+				if tt.capitalize && (word == "" || strings.ToUpper(word[:1]) != word[:1]) {
+					t.Errorf("%q is not capitalized in %q", word, passphrase)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const NameIndexContext = "gophkeeper-name-index-v1"
+
+// NameIndex computes a blind index of a resource name
+// The index is deterministic, so the server can look up a resource by exact name
+// without learning the name. It uses a key separate from the encryption key
+//
+// Parameters:
+//   - name: resource name in canonical form
+//
+// Returns:
+//   - string: hex-encoded HMAC-SHA256 of the name
+func (s *CryptoService) NameIndex(name string) string {
+	keyMAC := hmac.New(sha256.New, s.derivedKey)
+	keyMAC.Write([]byte(NameIndexContext))
+	indexKey := keyMAC.Sum(nil)
+
+	h := hmac.New(sha256.New, indexKey)
+	h.Write([]byte(name))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// EncryptName encrypts a resource name
+// Parameters:
+//   - name: resource name
+//
+// Returns:
+//   - []byte: encrypted name
+//   - error: error if the name encryption failed
+func (s *CryptoService) EncryptName(name string) ([]byte, error) {
+	return s.EncryptData([]byte(name))
+}
+
+// DecryptName decrypts a resource name encrypted by EncryptName
+// Parameters:
+//   - encryptedName: encrypted name
+//
+// Returns:
+//   - string: resource name
+//   - error: error if the name decryption failed
+func (s *CryptoService) DecryptName(encryptedName []byte) (string, error) {
+	name, err := s.DecryptData(encryptedName)
+	if err != nil {
+		return "", err
+	}
+	return string(name), nil
+}
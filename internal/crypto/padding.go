@@ -0,0 +1,60 @@
+package crypto
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// MinPaddedSize is the smallest padded plaintext size, so short secrets such as
+// passwords all look the same to the server
+const MinPaddedSize = 256
+
+// PaddingContext is authenticated together with padded ciphertexts to tell them apart from unpadded ones
+const PaddingContext = "gophkeeper-padme-v1"
+
+var ErrInvalidPadding = errors.New("invalid padding")
+
+// PaddedSize returns the size a plaintext of the given length is padded to
+// It uses the PADMÉ scheme: the size is rounded up so that only O(log log n) bits
+// of the length are revealed, with at most 12% overhead
+//
+// Parameters:
+//   - length: plaintext length including the padding marker
+//
+// Returns:
+//   - int: padded length, at least MinPaddedSize
+func PaddedSize(length int) int {
+	if length <= MinPaddedSize {
+		return MinPaddedSize
+	}
+
+	e := bits.Len(uint(length)) - 1 // floor(log2 length)
+	s := bits.Len(uint(e))          // floor(log2 e) + 1
+	mask := (1 << (e - s)) - 1
+	return (length + mask) &^ mask
+}
+
+// Pad pads plaintext to PaddedSize with the ISO/IEC 7816-4 scheme:
+// a 0x80 marker byte followed by zero bytes
+func Pad(plaintext []byte) []byte {
+	padded := make([]byte, PaddedSize(len(plaintext)+1))
+	copy(padded, plaintext)
+	padded[len(plaintext)] = 0x80
+	return padded
+}
+
+// Unpad removes padding added by Pad
+//
+// Returns:
+//   - []byte: original plaintext
+//   - error: ErrInvalidPadding if the marker byte is missing
+func Unpad(padded []byte) ([]byte, error) {
+	end := len(padded) - 1
+	for end >= 0 && padded[end] == 0 {
+		end--
+	}
+	if end < 0 || padded[end] != 0x80 {
+		return nil, ErrInvalidPadding
+	}
+	return padded[:end], nil
+}
@@ -0,0 +1,123 @@
+package crypto
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestPaddedSize(t *testing.T) {
+	tests := []struct {
+		length int
+		want   int
+	}{
+		{length: 0, want: MinPaddedSize},
+		{length: 1, want: MinPaddedSize},
+		{length: MinPaddedSize, want: MinPaddedSize},
+		{length: 257, want: 272},
+		{length: 1000, want: 1024},
+		{length: 1024, want: 1024},
+		{length: 1025, want: 1088},
+		{length: 1 << 20, want: 1 << 20},
+		{length: 1<<20 + 1, want: 1<<20 + 1<<15},
+	}
+
+	for _, tt := range tests {
+		if got := PaddedSize(tt.length); got != tt.want {
+			t.Errorf("PaddedSize(%d) = %d, want %d", tt.length, got, tt.want)
+		}
+	}
+}
+
+func TestPadUnpad(t *testing.T) {
+	tests := []struct {
+		name      string
+		plaintext []byte
+	}{
+		{name: "empty", plaintext: []byte{}},
+		{name: "short", plaintext: []byte("password")},
+		{name: "trailing zeros", plaintext: []byte{'a', 0, 0}},
+		{name: "trailing marker", plaintext: []byte{'a', 0x80}},
+		{name: "fills min size", plaintext: bytes.Repeat([]byte{'x'}, MinPaddedSize-1)},
+		{name: "exceeds min size", plaintext: bytes.Repeat([]byte{'x'}, MinPaddedSize)},
+		{name: "large", plaintext: bytes.Repeat([]byte{'x'}, 5000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			padded := Pad(tt.plaintext)
+			if len(padded) != PaddedSize(len(tt.plaintext)+1) {
+				t.Fatalf("len(Pad()) = %d, want %d", len(padded), PaddedSize(len(tt.plaintext)+1))
+			}
+			got, err := Unpad(padded)
+			if err != nil {
+				t.Fatalf("Unpad() error = %v", err)
+			}
+			if !bytes.Equal(got, tt.plaintext) {
+				t.Errorf("Unpad(Pad(%q)) = %q", tt.plaintext, got)
+			}
+		})
+	}
+}
+
+func TestUnpadInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		padded []byte
+	}{
+		{name: "empty", padded: []byte{}},
+		{name: "only zeros", padded: make([]byte, 16)},
+		{name: "no marker", padded: []byte{'a', 'b', 0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Unpad(tt.padded); !errors.Is(err, ErrInvalidPadding) {
+				t.Errorf("Unpad(%q) error = %v, want %v", tt.padded, err, ErrInvalidPadding)
+			}
+		})
+	}
+}
+
+func TestDecryptDataUnpaddedFallback(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	service := NewCryptoService(key)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: []byte{}},
+		{name: "text", data: []byte("stored before padding")},
+		{name: "ends with marker", data: []byte{'a', 0x80, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Data stored before padding was introduced
+			legacy, err := Encrypt(tt.data, key)
+			if err != nil {
+				t.Fatalf("Encrypt() error = %v", err)
+			}
+			got, err := service.DecryptData(legacy)
+			if err != nil {
+				t.Fatalf("DecryptData() of unpadded data error = %v", err)
+			}
+			if !bytes.Equal(got, tt.data) {
+				t.Errorf("DecryptData() of unpadded data = %q, want %q", got, tt.data)
+			}
+
+			padded, err := service.EncryptData(tt.data)
+			if err != nil {
+				t.Fatalf("EncryptData() error = %v", err)
+			}
+			got, err = service.DecryptData(padded)
+			if err != nil {
+				t.Fatalf("DecryptData() error = %v", err)
+			}
+			if !bytes.Equal(got, tt.data) {
+				t.Errorf("DecryptData(EncryptData(%q)) = %q", tt.data, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package crypto
+
+import (
+	_ "embed"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Patterns found by EstimateStrength
+const (
+	PatternCommonPassword = "common password"
+	PatternDictionaryWord = "dictionary word"
+	PatternUserInput      = "contains the user name or secret name"
+	PatternSequence       = "sequence like abc or 123"
+	PatternRepeat         = "repeated characters"
+	PatternKeyboard       = "keyboard pattern like qwerty"
+	PatternYear           = "year"
+)
+
+//go:embed common_passwords.txt
+var commonPasswordsData string
+
+// dictionary maps lower-case words to the number of guesses needed to find them in a dictionary attack
+var dictionary = func() map[string]float64 {
+	words := make(map[string]float64)
+	for i, w := range strings.Fields(commonPasswordsData) {
+		if _, ok := words[w]; !ok {
+			words[w] = float64(i + 1)
+		}
+	}
+	for _, w := range wordlist {
+		if _, ok := words[w]; !ok {
+			words[w] = float64(len(wordlist))
+		}
+	}
+	return words
+}()
+
+var commonPasswords = func() map[string]bool {
+	words := make(map[string]bool)
+	for _, w := range strings.Fields(commonPasswordsData) {
+		words[w] = true
+	}
+	return words
+}()
+
+var keyboardRows = []string{"1234567890", "qwertyuiop", "asdfghjkl", "zxcvbnm"}
+
+// leetSubstitutions maps characters commonly used instead of letters back to the letters
+var leetSubstitutions = strings.NewReplacer("@", "a", "4", "a", "3", "e", "1", "i", "!", "i", "0", "o", "$", "s", "5", "s", "7", "t", "+", "t")
+
+// Strength is an estimate of how hard a password is to guess
+type Strength struct {
+	Entropy  float64  `json:"entropy"`            // log2 of the estimated number of guesses
+	Score    int      `json:"score"`              // 0 (too guessable) to 4 (very unguessable)
+	Patterns []string `json:"patterns,omitempty"` // guessable patterns the password is made of
+}
+
+// match is a guessable part of a password
+type match struct {
+	start, end int // runes [start, end)
+	guesses    float64
+	pattern    string
+}
+
+// EstimateStrength estimates the number of guesses an attacker needs to find a password, in the
+// spirit of zxcvbn: the password is split into the cheapest sequence of dictionary words, common
+// passwords, sequences, repeats, keyboard patterns, years and brute-forced characters
+//
+// Parameters:
+//   - password: password to estimate
+//   - userInputs: words an attacker may know, e.g. the user name and the name of the secret
+//
+// Returns:
+//   - Strength: entropy in bits, score from 0 to 4 and the patterns found
+func EstimateStrength(password string, userInputs ...string) Strength {
+	runes := []rune(password)
+	if len(runes) == 0 {
+		return Strength{}
+	}
+
+	var inputs []string
+	for _, input := range userInputs {
+		for _, part := range strings.FieldsFunc(strings.ToLower(input), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			if len([]rune(part)) >= 3 {
+				inputs = append(inputs, part)
+			}
+		}
+	}
+
+	matches := findMatches(runes, inputs)
+	bruteforce := math.Log2(float64(cardinality(runes)))
+
+	// best[i] is the smallest log2 of guesses for the first i runes
+	best := make([]float64, len(runes)+1)
+	via := make([]*match, len(runes)+1)
+	for i := 1; i <= len(runes); i++ {
+		best[i] = best[i-1] + bruteforce
+		for j := range matches {
+			m := &matches[j]
+			if m.end != i {
+				continue
+			}
+			if cost := best[m.start] + math.Log2(m.guesses); cost < best[i] {
+				best[i] = cost
+				via[i] = m
+			}
+		}
+	}
+
+	strength := Strength{Entropy: best[len(runes)]}
+	seen := make(map[string]bool)
+	for i := len(runes); i > 0; {
+		m := via[i]
+		if m == nil {
+			i--
+			continue
+		}
+		if !seen[m.pattern] {
+			seen[m.pattern] = true
+			strength.Patterns = append(strength.Patterns, m.pattern)
+		}
+		i = m.start
+	}
+
+	// Thresholds of zxcvbn: 10^3, 10^6, 10^8 and 10^10 guesses
+	log10 := strength.Entropy * math.Log10(2)
+	switch {
+	case log10 < 3:
+		strength.Score = 0
+	case log10 < 6:
+		strength.Score = 1
+	case log10 < 8:
+		strength.Score = 2
+	case log10 < 10:
+		strength.Score = 3
+	default:
+		strength.Score = 4
+	}
+	return strength
+}
+
+func findMatches(runes []rune, userInputs []string) []match {
+	var matches []match
+	lower := []rune(strings.ToLower(string(runes)))
+
+	// Dictionary words, common passwords and user inputs, also with upper case letters and leet substitutions
+	for i := range lower {
+		for j := i + 3; j <= len(lower) && j-i <= 32; j++ {
+			word := string(lower[i:j])
+			unleet := leetSubstitutions.Replace(word)
+			variations := caseVariations(runes[i:j])
+			if unleet != word {
+				variations *= 2
+			}
+
+			for _, candidate := range []string{word, unleet} {
+				for _, input := range userInputs {
+					if candidate == input {
+						matches = append(matches, match{i, j, variations, PatternUserInput})
+					}
+				}
+				if guesses, ok := dictionary[candidate]; ok {
+					pattern := PatternDictionaryWord
+					if commonPasswords[candidate] {
+						pattern = PatternCommonPassword
+					}
+					matches = append(matches, match{i, j, guesses * variations, pattern})
+				}
+			}
+		}
+	}
+
+	// Repeated characters and sequences with a step of one, e.g. aaa, abc, 321
+	for i := 0; i < len(lower)-2; {
+		j := i + 1
+		for j < len(lower) && lower[j] == lower[i] {
+			j++
+		}
+		if j-i >= 3 {
+			matches = append(matches, match{i, j, float64(cardinality(runes[i:i+1]) * (j - i)), PatternRepeat})
+		}
+
+		k := i + 1
+		step := lower[k] - lower[i]
+		for k < len(lower) && (step == 1 || step == -1) && lower[k]-lower[k-1] == step && sameClass(lower[k], lower[i]) {
+			k++
+		}
+		if k-i >= 3 {
+			base := 26.0
+			if unicode.IsDigit(lower[i]) {
+				base = 10
+			}
+			if strings.ContainsRune("aAzZ019", lower[i]) {
+				base = 4 // obvious starting points
+			}
+			if step < 0 {
+				base *= 2
+			}
+			matches = append(matches, match{i, k, base * float64(k-i), PatternSequence})
+		}
+
+		i = max(j, i+1)
+	}
+
+	// Runs of neighbouring keys on a keyboard row, e.g. qwer or poiuy
+	for _, row := range keyboardRows {
+		for i := range lower {
+			j := i + 1
+			for j < len(lower) && keyboardNeighbours(row, lower[j-1], lower[j]) {
+				j++
+			}
+			if j-i >= 4 {
+				matches = append(matches, match{i, j, 50 * float64(j-i), PatternKeyboard})
+			}
+		}
+	}
+
+	// Years from 1900 to 2099
+	for i := 0; i+4 <= len(lower); i++ {
+		year := string(lower[i : i+4])
+		if (strings.HasPrefix(year, "19") || strings.HasPrefix(year, "20")) && isDigits(year) {
+			matches = append(matches, match{i, i + 4, 200, PatternYear})
+		}
+	}
+
+	return matches
+}
+
+// caseVariations returns the number of guesses added by upper case letters in a word
+func caseVariations(word []rune) float64 {
+	upper := 0
+	for _, r := range word {
+		if unicode.IsUpper(r) {
+			upper++
+		}
+	}
+	switch {
+	case upper == 0:
+		return 1
+	case upper == len(word), upper == 1 && unicode.IsUpper(word[0]), upper == 1 && unicode.IsUpper(word[len(word)-1]):
+		return 2
+	default:
+		return math.Pow(2, float64(min(upper, len(word)-upper)))
+	}
+}
+
+// cardinality returns the size of the character set an attacker has to brute force
+func cardinality(runes []rune) int {
+	var lower, upper, digits, symbols, other bool
+	for _, r := range runes {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digits = true
+		case r < unicode.MaxASCII:
+			symbols = true
+		default:
+			other = true
+		}
+	}
+
+	n := 0
+	for _, class := range []struct {
+		present bool
+		size    int
+	}{{lower, 26}, {upper, 26}, {digits, 10}, {symbols, 33}, {other, 100}} {
+		if class.present {
+			n += class.size
+		}
+	}
+	return n
+}
+
+func sameClass(a, b rune) bool {
+	return unicode.IsDigit(a) == unicode.IsDigit(b) && unicode.IsLetter(a) == unicode.IsLetter(b)
+}
+
+func keyboardNeighbours(row string, a, b rune) bool {
+	i, j := strings.IndexRune(row, a), strings.IndexRune(row, b)
+	return i >= 0 && j >= 0 && (i-j == 1 || j-i == 1)
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
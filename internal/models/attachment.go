@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// Attachment is a file attached to a resource
+// Its data is stored like resource data: in PostgreSQL if small, in MinIO otherwise
+type Attachment struct {
+	ID            int64       `db:"id"`
+	ResourceID    int64       `db:"resource_id"`
+	UserID        int64       `db:"user_id"`
+	EncryptedName []byte      `db:"encrypted_name"` // file name encrypted on the client
+	Storage       StorageType `db:"storage"`
+	ObjectKey     string      `db:"object_key"` // object key in MinIO if storage = minio
+	Size          int64       `db:"size"`
+	Metadata      []byte      `db:"metadata"` // metadata encrypted on the client, opaque to the server
+	Data          []byte      `db:"data"`     // data if storage = postgres
+	CreatedAt     time.Time   `db:"created_at"`
+}
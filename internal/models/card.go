@@ -0,0 +1,192 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidCardNumber = errors.New("invalid card number")
+	ErrInvalidCardExpiry = errors.New("invalid card expiry, expected MM/YY")
+	ErrInvalidCardCVV    = errors.New("invalid card CVV, expected 3 or 4 digits")
+	ErrInvalidCardPIN    = errors.New("invalid card PIN, expected 4 to 12 digits")
+)
+
+// Card is the payload of a card resource
+// It is serialized to JSON and encrypted on the client with CryptoService.EncryptData,
+// the JSON length is kept in metadata as the real size of the data
+type Card struct {
+	Holder      string `json:"holder,omitempty"`
+	Number      string `json:"number"` // digits only
+	ExpiryMonth int    `json:"expiry_month"`
+	ExpiryYear  int    `json:"expiry_year"` // four digits
+	CVV         string `json:"cvv,omitempty"`
+	PIN         string `json:"pin,omitempty"`
+	Issuer      string `json:"issuer,omitempty"`
+}
+
+// NormalizeCardNumber removes spaces and dashes from a card number
+func NormalizeCardNumber(number string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(number))
+}
+
+// ParseCardExpiry parses an expiry date in MM/YY or MM/YYYY format
+//
+// Returns:
+//   - month and four-digit year
+//   - ErrInvalidCardExpiry if the format or the month is invalid
+func ParseCardExpiry(expiry string) (int, int, error) {
+	monthPart, yearPart, ok := strings.Cut(strings.TrimSpace(expiry), "/")
+	if !ok || len(monthPart) != 2 || (len(yearPart) != 2 && len(yearPart) != 4) {
+		return 0, 0, ErrInvalidCardExpiry
+	}
+
+	month, err := strconv.Atoi(monthPart)
+	if err != nil || month < 1 || month > 12 {
+		return 0, 0, ErrInvalidCardExpiry
+	}
+	year, err := strconv.Atoi(yearPart)
+	if err != nil || year < 0 {
+		return 0, 0, ErrInvalidCardExpiry
+	}
+	if len(yearPart) == 2 {
+		year += 2000
+	}
+	return month, year, nil
+}
+
+// Validate checks the card number with the Luhn algorithm and the format of expiry, CVV and PIN
+// An expired card is valid, use IsExpired to detect it
+func (c *Card) Validate() error {
+	if !IsValidLuhn(c.Number) {
+		return ErrInvalidCardNumber
+	}
+	if c.ExpiryMonth < 1 || c.ExpiryMonth > 12 || c.ExpiryYear < 2000 {
+		return ErrInvalidCardExpiry
+	}
+	if c.CVV != "" && (!isDigits(c.CVV) || len(c.CVV) < 3 || len(c.CVV) > 4) {
+		return ErrInvalidCardCVV
+	}
+	if c.PIN != "" && (!isDigits(c.PIN) || len(c.PIN) < 4 || len(c.PIN) > 12) {
+		return ErrInvalidCardPIN
+	}
+	return nil
+}
+
+// Expiry returns the expiry date in MM/YY format
+func (c *Card) Expiry() string {
+	return fmt.Sprintf("%02d/%02d", c.ExpiryMonth, c.ExpiryYear%100)
+}
+
+// IsExpired reports whether the card is no longer valid at the given time
+// A card is valid through the last day of its expiry month
+func (c *Card) IsExpired(now time.Time) bool {
+	firstInvalidDay := time.Date(c.ExpiryYear, time.Month(c.ExpiryMonth)+1, 1, 0, 0, 0, 0, now.Location())
+	return !now.Before(firstInvalidDay)
+}
+
+// Brand detects the payment network from the card number prefix
+// Returns "Unknown" if the prefix is not recognized
+func (c *Card) Brand() string {
+	n := c.Number
+	prefix := func(digits int) int {
+		if len(n) < digits {
+			return -1
+		}
+		v, _ := strconv.Atoi(n[:digits])
+		return v
+	}
+	between := func(digits, from, to int) bool {
+		p := prefix(digits)
+		return p >= from && p <= to
+	}
+
+	switch {
+	case strings.HasPrefix(n, "4"):
+		return "Visa"
+	case between(2, 51, 55), between(4, 2221, 2720):
+		return "Mastercard"
+	case between(4, 2200, 2204):
+		return "Mir"
+	case prefix(2) == 34, prefix(2) == 37:
+		return "American Express"
+	case prefix(4) == 6011, prefix(2) == 65, between(3, 644, 649):
+		return "Discover"
+	case between(4, 3528, 3589):
+		return "JCB"
+	case between(3, 300, 305), prefix(2) == 36, prefix(2) == 38:
+		return "Diners Club"
+	case prefix(2) == 62:
+		return "UnionPay"
+	case prefix(2) == 50, between(2, 56, 69):
+		return "Maestro"
+	}
+	return "Unknown"
+}
+
+// MaskedNumber returns the card number with all but the last four digits hidden
+func (c *Card) MaskedNumber() string {
+	if len(c.Number) <= 4 {
+		return c.Number
+	}
+	return "**** " + c.Number[len(c.Number)-4:]
+}
+
+// Field returns the value of a field by name (case-insensitive)
+// Supported names: holder, number, expiry, cvv, pin, issuer, brand
+func (c *Card) Field(name string) (string, bool) {
+	switch strings.ToLower(name) {
+	case "holder":
+		return c.Holder, true
+	case "number":
+		return c.Number, true
+	case "expiry":
+		return c.Expiry(), true
+	case "cvv":
+		return c.CVV, true
+	case "pin":
+		return c.PIN, true
+	case "issuer":
+		return c.Issuer, true
+	case "brand":
+		return c.Brand(), true
+	}
+	return "", false
+}
+
+// IsValidLuhn checks a digits-only card number with the Luhn algorithm
+func IsValidLuhn(number string) bool {
+	if len(number) < 12 || len(number) > 19 || !isDigits(number) {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
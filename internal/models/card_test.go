@@ -0,0 +1,58 @@
+package models
+
+import "testing"
+
+func TestIsValidLuhn(t *testing.T) {
+	tests := []struct {
+		name   string
+		number string
+		want   bool
+	}{
+		{name: "visa", number: "4111111111111111", want: true},
+		{name: "amex 15 digits", number: "378282246310005", want: true},
+		{name: "diners 14 digits", number: "30569309025904", want: true},
+		{name: "wrong check digit", number: "4111111111111112", want: false},
+		{name: "too short", number: "42", want: false},
+		{name: "too long", number: "41111111111111111111", want: false},
+		{name: "not normalized", number: "4111 1111 1111 1111", want: false},
+		{name: "letters", number: "411111111111111a", want: false},
+		{name: "empty", number: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidLuhn(tt.number); got != tt.want {
+				t.Errorf("IsValidLuhn(%q) = %v, want %v", tt.number, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCardBrand(t *testing.T) {
+	tests := []struct {
+		number string
+		want   string
+	}{
+		{number: "4111111111111111", want: "Visa"},
+		{number: "5555555555554444", want: "Mastercard"},
+		{number: "2221000000000009", want: "Mastercard"},
+		{number: "2200000000000004", want: "Mir"},
+		{number: "378282246310005", want: "American Express"},
+		{number: "6011111111111117", want: "Discover"},
+		{number: "3530111333300000", want: "JCB"},
+		{number: "30569309025904", want: "Diners Club"},
+		{number: "6200000000000005", want: "UnionPay"},
+		{number: "6759649826438453", want: "Maestro"},
+		{number: "9999999999999995", want: "Unknown"},
+		{number: "", want: "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want+"/"+tt.number, func(t *testing.T) {
+			card := &Card{Number: tt.number}
+			if got := card.Brand(); got != tt.want {
+				t.Errorf("Brand() of %q = %q, want %q", tt.number, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Credential is the payload of a credentials resource
+// It is serialized to JSON and encrypted on the client with CryptoService.EncryptData,
+// the JSON length is kept in metadata as the real size of the data
+type Credential struct {
+	Username     string        `json:"username"`
+	Password     string        `json:"password"`
+	URLs         []string      `json:"urls,omitempty"`
+	Notes        string        `json:"notes,omitempty"`
+	CustomFields []CustomField `json:"custom_fields,omitempty"`
+	// PasswordHistory holds previous passwords, the most recent first, at most PasswordHistoryLimit
+	PasswordHistory []PasswordHistoryEntry `json:"password_history,omitempty"`
+}
+
+// PasswordHistoryLimit is the number of previous passwords kept in a credential
+const PasswordHistoryLimit = 10
+
+// PasswordHistoryEntry is a previous password of a credential
+type PasswordHistoryEntry struct {
+	Password  string    `json:"password"`
+	ChangedAt time.Time `json:"changed_at"` // when the password was replaced
+}
+
+// CustomField is an additional user-defined field of a credential
+type CustomField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Secret bool   `json:"secret,omitempty"` // hidden on input like a password
+}
+
+// Field returns the value of a field by name (case-insensitive)
+// Supported names: username, password, url (the first URL), urls (one per line), notes
+// and names of custom fields
+func (c *Credential) Field(name string) (string, bool) {
+	switch strings.ToLower(name) {
+	case "username":
+		return c.Username, true
+	case "password":
+		return c.Password, true
+	case "url":
+		if len(c.URLs) == 0 {
+			return "", true
+		}
+		return c.URLs[0], true
+	case "urls":
+		return strings.Join(c.URLs, "\n"), true
+	case "notes":
+		return c.Notes, true
+	}
+
+	for _, f := range c.CustomFields {
+		if strings.EqualFold(f.Name, name) {
+			return f.Value, true
+		}
+	}
+	return "", false
+}
+
+// SetCustomField adds a custom field or replaces the value of an existing one
+func (c *Credential) SetCustomField(field CustomField) {
+	for i, f := range c.CustomFields {
+		if strings.EqualFold(f.Name, field.Name) {
+			c.CustomFields[i] = field
+			return
+		}
+	}
+	c.CustomFields = append(c.CustomFields, field)
+}
+
+// ChangePassword replaces the password and moves the current one to the history
+// The history is trimmed to PasswordHistoryLimit entries. An empty or unchanged password is ignored
+func (c *Credential) ChangePassword(password string, now time.Time) {
+	if password == "" || password == c.Password {
+		return
+	}
+
+	if c.Password != "" {
+		history := append([]PasswordHistoryEntry{{Password: c.Password, ChangedAt: now.UTC()}}, c.PasswordHistory...)
+		if len(history) > PasswordHistoryLimit {
+			history = history[:PasswordHistoryLimit]
+		}
+		c.PasswordHistory = history
+	}
+	c.Password = password
+}
+
+// UsedPassword reports whether the password is one of the previous passwords
+func (c *Credential) UsedPassword(password string) bool {
+	for _, entry := range c.PasswordHistory {
+		if entry.Password == password {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCredentialChangePassword(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// history returns n previous passwords, the most recent first: p<n-1>, ..., p0
+	history := func(n int) []PasswordHistoryEntry {
+		entries := make([]PasswordHistoryEntry, n)
+		for i := range entries {
+			entries[i] = PasswordHistoryEntry{Password: fmt.Sprintf("p%d", n-1-i), ChangedAt: now.Add(-time.Duration(i+1) * time.Hour)}
+		}
+		return entries
+	}
+
+	tests := []struct {
+		name        string
+		credential  Credential
+		password    string
+		wantHistory []string
+	}{
+		{name: "first password", credential: Credential{}, password: "new", wantHistory: []string{}},
+		{name: "empty password ignored", credential: Credential{Password: "old"}, password: "", wantHistory: []string{}},
+		{name: "same password ignored", credential: Credential{Password: "old"}, password: "old", wantHistory: []string{}},
+		{name: "old password kept", credential: Credential{Password: "old"}, password: "new", wantHistory: []string{"old"}},
+		{
+			name:        "most recent first",
+			credential:  Credential{Password: "old", PasswordHistory: history(2)},
+			password:    "new",
+			wantHistory: []string{"old", "p1", "p0"},
+		},
+		{
+			name:        "fills the limit",
+			credential:  Credential{Password: "old", PasswordHistory: history(PasswordHistoryLimit - 1)},
+			password:    "new",
+			wantHistory: []string{"old", "p8", "p7", "p6", "p5", "p4", "p3", "p2", "p1", "p0"},
+		},
+		{
+			name:        "oldest dropped at limit",
+			credential:  Credential{Password: "old", PasswordHistory: history(PasswordHistoryLimit)},
+			password:    "new",
+			wantHistory: []string{"old", "p9", "p8", "p7", "p6", "p5", "p4", "p3", "p2", "p1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			credential := tt.credential
+			wantPassword := tt.password
+			if wantPassword == "" {
+				wantPassword = tt.credential.Password
+			}
+
+			credential.ChangePassword(tt.password, now)
+
+			if credential.Password != wantPassword {
+				t.Errorf("Password = %q, want %q", credential.Password, wantPassword)
+			}
+			if len(credential.PasswordHistory) > PasswordHistoryLimit {
+				t.Errorf("history has %d entries, limit is %d", len(credential.PasswordHistory), PasswordHistoryLimit)
+			}
+			if len(credential.PasswordHistory) != len(tt.wantHistory) {
+				t.Fatalf("history has %d entries, want %d", len(credential.PasswordHistory), len(tt.wantHistory))
+			}
+			for i, want := range tt.wantHistory {
+				if got := credential.PasswordHistory[i].Password; got != want {
+					t.Errorf("history[%d] = %q, want %q", i, got, want)
+				}
+			}
+			if len(tt.wantHistory) > 0 && tt.wantHistory[0] == "old" {
+				if got := credential.PasswordHistory[0].ChangedAt; !got.Equal(now) {
+					t.Errorf("history[0].ChangedAt = %v, want %v", got, now)
+				}
+			}
+		})
+	}
+}
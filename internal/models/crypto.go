@@ -15,5 +15,5 @@ type DecryptedResource struct {
 	Name     string
 	Type     ResourceType
 	Data     []byte
-	Metadata map[string]string
+	Metadata ResourceMetadata
 }
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ResourceMetadata is user-defined metadata of a resource
+// It is serialized to JSON and encrypted on the client with CryptoService.EncryptJSON,
+// the server stores it as opaque bytes and never sees tags or values
+type ResourceMetadata struct {
+	Tags   []string          `json:"tags,omitempty"`
+	Values map[string]string `json:"values,omitempty"`
+	// Size is the real size of the data, the server only knows the padded size
+	Size int64 `json:"size,omitempty"`
+	// File describes the file the data was read from, nil if it was not read from a file
+	File *FileInfo `json:"file,omitempty"`
+}
+
+// FileInfo is the original name, permissions and modification time of a stored file
+type FileInfo struct {
+	Name    string      `json:"name"` // base name without directories
+	Mode    os.FileMode `json:"mode"` // permission bits only
+	ModTime time.Time   `json:"mtime"`
+}
+
+// IsEmpty reports whether the metadata has neither tags, values, size nor file information
+func (m *ResourceMetadata) IsEmpty() bool {
+	return len(m.Tags) == 0 && len(m.Values) == 0 && m.Size == 0 && m.File == nil
+}
+
+// AddTag adds a tag if it is not present yet, tags are compared case-insensitively
+func (m *ResourceMetadata) AddTag(tag string) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" || m.HasTag(tag) {
+		return
+	}
+	m.Tags = append(m.Tags, tag)
+}
+
+// HasTag reports whether the metadata has the tag (case-insensitive)
+func (m *ResourceMetadata) HasTag(tag string) bool {
+	return slices.ContainsFunc(m.Tags, func(t string) bool {
+		return strings.EqualFold(t, tag)
+	})
+}
+
+// RemoveTag removes a tag (case-insensitive)
+func (m *ResourceMetadata) RemoveTag(tag string) {
+	m.Tags = slices.DeleteFunc(m.Tags, func(t string) bool {
+		return strings.EqualFold(t, strings.TrimSpace(tag))
+	})
+}
+
+// SetValue sets a metadata value, an empty value removes the key
+func (m *ResourceMetadata) SetValue(key, value string) {
+	if value == "" {
+		delete(m.Values, key)
+		return
+	}
+	if m.Values == nil {
+		m.Values = make(map[string]string)
+	}
+	m.Values[key] = value
+}
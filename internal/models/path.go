@@ -0,0 +1,62 @@
+package models
+
+import (
+	"errors"
+	"strings"
+)
+
+// PathSeparator separates folders in resource names, e.g. "prod/db/password"
+const PathSeparator = "/"
+
+// MaxResourceNameLength is the maximum length of a resource name
+const MaxResourceNameLength = 255
+
+var (
+	ErrInvalidResourceName = errors.New("invalid resource name")
+)
+
+// CleanResourceName validates a slash-separated resource name and returns it in canonical form:
+// leading and trailing separators and spaces around segments are removed
+// Returns ErrInvalidResourceName if the name is empty or contains empty, "." or ".." segments
+func CleanResourceName(name string) (string, error) {
+	cleaned, err := CleanPathPrefix(name)
+	if err != nil {
+		return "", err
+	}
+	if cleaned == "" {
+		return "", ErrInvalidResourceName
+	}
+	return cleaned, nil
+}
+
+// CleanPathPrefix validates a folder path used for filtering and moving resources
+// The empty path denotes the root folder
+func CleanPathPrefix(path string) (string, error) {
+	path = strings.Trim(strings.TrimSpace(path), PathSeparator)
+	if path == "" {
+		return "", nil
+	}
+
+	segments := strings.Split(path, PathSeparator)
+	for i, segment := range segments {
+		segment = strings.TrimSpace(segment)
+		if segment == "" || segment == "." || segment == ".." {
+			return "", ErrInvalidResourceName
+		}
+		segments[i] = segment
+	}
+
+	cleaned := strings.Join(segments, PathSeparator)
+	if len(cleaned) > MaxResourceNameLength {
+		return "", ErrInvalidResourceName
+	}
+	return cleaned, nil
+}
+
+// IsInFolder checks if the resource name lies inside the folder (at any depth)
+func IsInFolder(name, folder string) bool {
+	if folder == "" {
+		return true
+	}
+	return strings.HasPrefix(name, folder+PathSeparator)
+}
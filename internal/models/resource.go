@@ -9,6 +9,8 @@ const (
 	TypeText        ResourceType = "text"
 	TypeBinary      ResourceType = "binary"
 	TypeCard        ResourceType = "card"
+	TypeSSHKey      ResourceType = "ssh_key"
+	TypeTOTP        ResourceType = "totp"
 )
 
 type StorageType string
@@ -19,15 +21,34 @@ const (
 )
 
 type Resource struct {
-	ID        int64        `db:"id"`
-	UserID    int64        `db:"user_id"`
-	Name      string       `db:"name"`
-	Type      ResourceType `db:"type"`
-	Storage   StorageType  `db:"storage"`
-	ObjectKey string       `db:"object_key"` // object key in MinIO if storage = minio
-	Size      int64        `db:"size"`
-	Metadata  []byte       `db:"metadata"` // encrypted metadata if storage = minio
-	Data      []byte       `db:"data"`     // data if storage = postgres
-	CreatedAt time.Time    `db:"created_at"`
-	UpdatedAt time.Time    `db:"updated_at"`
+	ID            int64        `db:"id"`
+	UserID        int64        `db:"user_id"`
+	Name          string       `db:"name"`           // blind index of the name if EncryptedName is set
+	EncryptedName []byte       `db:"encrypted_name"` // name encrypted on the client, nil for plaintext names
+	Type          ResourceType `db:"type"`
+	Storage       StorageType  `db:"storage"`
+	ObjectKey     string       `db:"object_key"` // object key in MinIO if storage = minio
+	Size          int64        `db:"size"`
+	Metadata      []byte       `db:"metadata"` // metadata encrypted on the client, opaque to the server
+	Data          []byte       `db:"data"`     // data if storage = postgres
+	Revision      int64        `db:"revision"` // incremented on every change
+	CreatedAt     time.Time    `db:"created_at"`
+	UpdatedAt     time.Time    `db:"updated_at"`
+	DeletedAt     *time.Time   `db:"deleted_at"`   // set when the resource is in trash
+	ExpiresAt     *time.Time   `db:"expires_at"`   // the secret must be replaced by this time, nil if it does not expire
+	RotateEvery   int64        `db:"rotate_every"` // rotation interval in seconds, 0 if the secret is not rotated
+	RotatedAt     time.Time    `db:"rotated_at"`   // when the secret value last changed
+}
+
+// DueAt returns when the resource has to be rotated: the earlier of ExpiresAt and
+// RotatedAt + RotateEvery, nil if neither is set
+func (r *Resource) DueAt() *time.Time {
+	due := r.ExpiresAt
+	if r.RotateEvery > 0 {
+		rotation := r.RotatedAt.Add(time.Duration(r.RotateEvery) * time.Second)
+		if due == nil || rotation.Before(*due) {
+			due = &rotation
+		}
+	}
+	return due
 }
@@ -0,0 +1,230 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidTypeDefinition = errors.New("invalid resource type definition")
+	ErrInvalidFieldValue     = errors.New("invalid field value")
+)
+
+// FieldKind is the kind of value of a field, it defines how the value is validated
+type FieldKind string
+
+const (
+	KindString  FieldKind = "string"
+	KindText    FieldKind = "text" // multi-line string
+	KindNumber  FieldKind = "number"
+	KindBoolean FieldKind = "boolean"
+	KindDate    FieldKind = "date" // YYYY-MM-DD
+	KindURL     FieldKind = "url"
+)
+
+// IsValid checks if the kind is one of the supported kinds
+func (k FieldKind) IsValid() bool {
+	switch k {
+	case KindString, KindText, KindNumber, KindBoolean, KindDate, KindURL:
+		return true
+	default:
+		return false
+	}
+}
+
+// FieldDefinition describes one field of a resource type
+type FieldDefinition struct {
+	Name        string    `json:"name"`
+	Label       string    `json:"label,omitempty"` // shown instead of the name if set
+	Kind        FieldKind `json:"kind"`
+	Secret      bool      `json:"secret,omitempty"` // prompted hidden and masked on output
+	Required    bool      `json:"required,omitempty"`
+	Description string    `json:"description,omitempty"`
+}
+
+// DisplayName returns the label of the field, or its name if there is no label
+func (f *FieldDefinition) DisplayName() string {
+	if f.Label != "" {
+		return f.Label
+	}
+	return f.Name
+}
+
+// ValidateValue checks that a non-empty value matches the kind of the field
+func (f *FieldDefinition) ValidateValue(value string) error {
+	if value == "" {
+		if f.Required {
+			return fmt.Errorf("%w: %s is required", ErrInvalidFieldValue, f.Name)
+		}
+		return nil
+	}
+
+	var err error
+	switch f.Kind {
+	case KindNumber:
+		_, err = strconv.ParseFloat(value, 64)
+	case KindBoolean:
+		_, err = strconv.ParseBool(value)
+	case KindDate:
+		_, err = time.Parse(time.DateOnly, value)
+	case KindURL:
+		var u *url.URL
+		u, err = url.Parse(value)
+		if err == nil && (u.Scheme == "" || u.Host == "") {
+			err = errors.New("expected an absolute URL")
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s must be a %s", ErrInvalidFieldValue, f.Name, f.Kind)
+	}
+	return nil
+}
+
+// TypeDefinition is the schema of a resource type
+// Built-in types are handled by the client specially, user-defined types are rendered from their fields
+type TypeDefinition struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Fields      []FieldDefinition `json:"fields"`
+	BuiltIn     bool              `json:"built_in,omitempty"`
+}
+
+var identifierPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+// Validate checks the type and field names and field kinds
+func (d *TypeDefinition) Validate() error {
+	if !identifierPattern.MatchString(d.Name) {
+		return fmt.Errorf("%w: type name must be 1 to 32 lowercase letters, digits or '_' starting with a letter", ErrInvalidTypeDefinition)
+	}
+	if len(d.Fields) == 0 {
+		return fmt.Errorf("%w: at least one field is required", ErrInvalidTypeDefinition)
+	}
+
+	seen := make(map[string]bool, len(d.Fields))
+	for _, f := range d.Fields {
+		if !identifierPattern.MatchString(f.Name) {
+			return fmt.Errorf("%w: field name %q must be 1 to 32 lowercase letters, digits or '_' starting with a letter", ErrInvalidTypeDefinition, f.Name)
+		}
+		if seen[f.Name] {
+			return fmt.Errorf("%w: duplicate field %q", ErrInvalidTypeDefinition, f.Name)
+		}
+		seen[f.Name] = true
+		if !f.Kind.IsValid() {
+			return fmt.Errorf("%w: field %q has unknown kind %q", ErrInvalidTypeDefinition, f.Name, f.Kind)
+		}
+	}
+	return nil
+}
+
+// Field returns the definition of a field by name (case-insensitive)
+func (d *TypeDefinition) Field(name string) (*FieldDefinition, bool) {
+	for i := range d.Fields {
+		if strings.EqualFold(d.Fields[i].Name, name) {
+			return &d.Fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// ValidateRecord checks that a record has all required fields, no unknown fields
+// and that every value matches the kind of its field
+func (d *TypeDefinition) ValidateRecord(record *Record) error {
+	for name := range record.Fields {
+		if _, ok := d.Field(name); !ok {
+			return fmt.Errorf("%w: unknown field %s", ErrInvalidFieldValue, name)
+		}
+	}
+	for _, f := range d.Fields {
+		if err := f.ValidateValue(record.Fields[f.Name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Record is the payload of a resource of a user-defined type
+// It is serialized to JSON and encrypted on the client with CryptoService.EncryptData,
+// the JSON length is kept in metadata as the real size of the data
+type Record struct {
+	Fields map[string]string `json:"fields"`
+}
+
+// BuiltInTypes describes the types known to every client
+// Their payloads are handled by dedicated code, the fields are informational
+var BuiltInTypes = []TypeDefinition{
+	{
+		Name:        string(TypeCredentials),
+		Description: "Login and password",
+		BuiltIn:     true,
+		Fields: []FieldDefinition{
+			{Name: "username", Kind: KindString},
+			{Name: "password", Kind: KindString, Secret: true, Required: true},
+			{Name: "url", Kind: KindURL},
+			{Name: "notes", Kind: KindText},
+		},
+	},
+	{
+		Name:        string(TypeText),
+		Description: "Arbitrary text",
+		BuiltIn:     true,
+		Fields:      []FieldDefinition{{Name: "value", Kind: KindText, Required: true}},
+	},
+	{
+		Name:        string(TypeBinary),
+		Description: "Arbitrary file",
+		BuiltIn:     true,
+		Fields:      []FieldDefinition{{Name: "value", Kind: KindText, Required: true, Description: "contents of the file"}},
+	},
+	{
+		Name:        string(TypeCard),
+		Description: "Bank card",
+		BuiltIn:     true,
+		Fields: []FieldDefinition{
+			{Name: "holder", Kind: KindString},
+			{Name: "number", Kind: KindString, Secret: true, Required: true},
+			{Name: "expiry", Kind: KindString, Required: true, Description: "MM/YY"},
+			{Name: "cvv", Kind: KindString, Secret: true},
+			{Name: "pin", Kind: KindString, Secret: true},
+			{Name: "issuer", Kind: KindString},
+		},
+	},
+	{
+		Name:        string(TypeSSHKey),
+		Description: "SSH key pair",
+		BuiltIn:     true,
+		Fields: []FieldDefinition{
+			{Name: "private_key", Kind: KindText, Secret: true, Required: true},
+			{Name: "public_key", Kind: KindString},
+			{Name: "comment", Kind: KindString},
+			{Name: "passphrase", Kind: KindString, Secret: true},
+		},
+	},
+	{
+		Name:        string(TypeTOTP),
+		Description: "Seed of time-based one-time passwords",
+		BuiltIn:     true,
+		Fields: []FieldDefinition{
+			{Name: "secret", Kind: KindString, Secret: true, Required: true},
+			{Name: "issuer", Kind: KindString},
+			{Name: "account", Kind: KindString},
+			{Name: "algorithm", Kind: KindString},
+			{Name: "digits", Kind: KindNumber},
+			{Name: "period", Kind: KindNumber},
+		},
+	},
+}
+
+// IsBuiltInType checks if the type is one of BuiltInTypes
+func IsBuiltInType(t ResourceType) bool {
+	for _, d := range BuiltInTypes {
+		if d.Name == string(t) {
+			return true
+		}
+	}
+	return false
+}
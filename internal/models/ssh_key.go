@@ -0,0 +1,199 @@
+package models
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+var (
+	ErrInvalidSSHKey      = errors.New("invalid SSH private key")
+	ErrSSHKeyMismatch     = errors.New("public key does not match the private key")
+	ErrSSHPassphrase      = errors.New("wrong passphrase for SSH private key")
+	ErrUnsupportedSSHType = errors.New("unsupported SSH key type, use ed25519, rsa or ecdsa")
+)
+
+// SSHKey is the payload of an ssh_key resource
+// It is serialized to JSON and encrypted on the client with CryptoService.EncryptData,
+// the JSON length is kept in metadata as the real size of the data
+type SSHKey struct {
+	PrivateKey string `json:"private_key"` // PEM, may be protected by Passphrase
+	PublicKey  string `json:"public_key"`  // authorized_keys format without comment
+	Comment    string `json:"comment,omitempty"`
+	Passphrase string `json:"passphrase,omitempty"`
+}
+
+// NewSSHKey parses a PEM private key and derives its public key
+// Returns ErrSSHPassphrase if the key is protected and passphrase is wrong or empty
+func NewSSHKey(privateKey []byte, passphrase, comment string) (*SSHKey, error) {
+	key := &SSHKey{
+		PrivateKey: string(privateKey),
+		Comment:    comment,
+		Passphrase: passphrase,
+	}
+
+	// A passphrase is kept only for keys protected by it
+	if _, err := ssh.ParseRawPrivateKey(privateKey); err == nil {
+		key.Passphrase = ""
+	}
+
+	signer, err := key.Signer()
+	if err != nil {
+		return nil, err
+	}
+	key.PublicKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey())))
+	return key, nil
+}
+
+// GenerateSSHKey generates a new key pair
+// Parameters:
+//   - keyType: ed25519, rsa or ecdsa
+//   - bits: size of rsa (default 4096) or ecdsa (256, 384 or 521, default 256) keys, ignored for ed25519
+//   - comment: comment of the key, usually user@host
+//   - passphrase: passphrase to protect the private key with, may be empty
+func GenerateSSHKey(keyType string, bits int, comment, passphrase string) (*SSHKey, error) {
+	var privateKey any
+	var err error
+
+	switch keyType {
+	case "ed25519":
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	case "rsa":
+		if bits == 0 {
+			bits = 4096
+		}
+		if bits < 2048 {
+			return nil, fmt.Errorf("rsa keys must be at least 2048 bits")
+		}
+		privateKey, err = rsa.GenerateKey(rand.Reader, bits)
+	case "ecdsa":
+		var curve elliptic.Curve
+		switch bits {
+		case 0, 256:
+			curve = elliptic.P256()
+		case 384:
+			curve = elliptic.P384()
+		case 521:
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("ecdsa keys must be 256, 384 or 521 bits")
+		}
+		privateKey, err = ecdsa.GenerateKey(curve, rand.Reader)
+	default:
+		return nil, ErrUnsupportedSSHType
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	var block *pem.Block
+	if passphrase == "" {
+		block, err = ssh.MarshalPrivateKey(privateKey, comment)
+	} else {
+		block, err = ssh.MarshalPrivateKeyWithPassphrase(privateKey, comment, []byte(passphrase))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode key: %w", err)
+	}
+
+	return NewSSHKey(pem.EncodeToMemory(block), passphrase, comment)
+}
+
+// Validate checks that the private key can be parsed and matches the public key
+func (k *SSHKey) Validate() error {
+	signer, err := k.Signer()
+	if err != nil {
+		return err
+	}
+
+	if k.PublicKey == "" {
+		return nil
+	}
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(k.PublicKey))
+	if err != nil {
+		return fmt.Errorf("invalid SSH public key: %w", err)
+	}
+	if string(publicKey.Marshal()) != string(signer.PublicKey().Marshal()) {
+		return ErrSSHKeyMismatch
+	}
+	return nil
+}
+
+// RawPrivateKey parses the private key, decrypting it with the passphrase if needed
+// Returns a *rsa.PrivateKey, *ecdsa.PrivateKey or *ed25519.PrivateKey
+func (k *SSHKey) RawPrivateKey() (any, error) {
+	var key any
+	var err error
+	if k.Passphrase == "" {
+		key, err = ssh.ParseRawPrivateKey([]byte(k.PrivateKey))
+	} else {
+		key, err = ssh.ParseRawPrivateKeyWithPassphrase([]byte(k.PrivateKey), []byte(k.Passphrase))
+	}
+
+	var missing *ssh.PassphraseMissingError
+	switch {
+	case err == nil:
+		return key, nil
+	case errors.As(err, &missing), errors.Is(err, x509.IncorrectPasswordError):
+		return nil, ErrSSHPassphrase
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSSHKey, err)
+	}
+}
+
+// Signer returns a signer for the private key
+func (k *SSHKey) Signer() (ssh.Signer, error) {
+	key, err := k.RawPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSSHKey, err)
+	}
+	return signer, nil
+}
+
+// AuthorizedKey returns the public key with its comment in authorized_keys format
+func (k *SSHKey) AuthorizedKey() string {
+	if k.Comment == "" {
+		return k.PublicKey
+	}
+	return k.PublicKey + " " + k.Comment
+}
+
+// Fingerprint returns the SHA256 fingerprint of the public key, e.g. SHA256:abc...
+func (k *SSHKey) Fingerprint() string {
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(k.PublicKey))
+	if err != nil {
+		return ""
+	}
+	return ssh.FingerprintSHA256(publicKey)
+}
+
+// Field returns the value of a field by name (case-insensitive)
+// Supported names: private_key, public_key (with comment), comment, passphrase, fingerprint
+func (k *SSHKey) Field(name string) (string, bool) {
+	switch strings.ToLower(name) {
+	case "private_key":
+		return k.PrivateKey, true
+	case "public_key":
+		return k.AuthorizedKey(), true
+	case "comment":
+		return k.Comment, true
+	case "passphrase":
+		return k.Passphrase, true
+	case "fingerprint":
+		return k.Fingerprint(), true
+	}
+	return "", false
+}
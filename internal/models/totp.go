@@ -0,0 +1,216 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidTOTPSecret    = errors.New("invalid TOTP secret, expected base32")
+	ErrInvalidTOTPAlgorithm = errors.New("invalid TOTP algorithm, use SHA1, SHA256 or SHA512")
+	ErrInvalidTOTPDigits    = errors.New("invalid TOTP digits, use 6 or 8")
+	ErrInvalidTOTPPeriod    = errors.New("invalid TOTP period, expected 1 to 300 seconds")
+	ErrInvalidOTPAuthURI    = errors.New("invalid otpauth URI, expected otpauth://totp/...")
+)
+
+// Defaults of the otpauth URI format, used by most authenticator apps
+const (
+	DefaultTOTPAlgorithm = "SHA1"
+	DefaultTOTPDigits    = 6
+	DefaultTOTPPeriod    = 30
+)
+
+// TOTP is the payload of a totp resource, a seed of time-based one-time passwords (RFC 6238)
+// It is serialized to JSON and encrypted on the client with CryptoService.EncryptData,
+// the JSON length is kept in metadata as the real size of the data
+type TOTP struct {
+	Secret    string `json:"secret"` // base32 without padding and spaces, upper case
+	Issuer    string `json:"issuer,omitempty"`
+	Account   string `json:"account,omitempty"`
+	Algorithm string `json:"algorithm"` // SHA1, SHA256 or SHA512
+	Digits    int    `json:"digits"`
+	Period    int    `json:"period"` // seconds
+}
+
+// NewTOTP creates a TOTP seed with default parameters from a base32 secret
+func NewTOTP(secret string) *TOTP {
+	return &TOTP{
+		Secret:    NormalizeTOTPSecret(secret),
+		Algorithm: DefaultTOTPAlgorithm,
+		Digits:    DefaultTOTPDigits,
+		Period:    DefaultTOTPPeriod,
+	}
+}
+
+// NormalizeTOTPSecret removes spaces, dashes and padding from a base32 secret and upper-cases it
+func NormalizeTOTPSecret(secret string) string {
+	secret = strings.NewReplacer(" ", "", "-", "", "=", "").Replace(strings.TrimSpace(secret))
+	return strings.ToUpper(secret)
+}
+
+// ParseOTPAuthURI parses an otpauth://totp/ URI as exported by authenticator apps and QR codes
+// Missing parameters get their defaults: SHA1, 6 digits, 30 seconds
+func ParseOTPAuthURI(uri string) (*TOTP, error) {
+	u, err := url.Parse(strings.TrimSpace(uri))
+	if err != nil || u.Scheme != "otpauth" || !strings.EqualFold(u.Host, "totp") {
+		return nil, ErrInvalidOTPAuthURI
+	}
+
+	query := u.Query()
+	totp := NewTOTP(query.Get("secret"))
+
+	// The label is "issuer:account" or just "account"
+	label := strings.TrimPrefix(u.Path, "/")
+	if issuer, account, ok := strings.Cut(label, ":"); ok {
+		totp.Issuer = strings.TrimSpace(issuer)
+		totp.Account = strings.TrimSpace(account)
+	} else {
+		totp.Account = strings.TrimSpace(label)
+	}
+	if issuer := query.Get("issuer"); issuer != "" {
+		totp.Issuer = issuer
+	}
+
+	if algorithm := query.Get("algorithm"); algorithm != "" {
+		totp.Algorithm = strings.ToUpper(algorithm)
+	}
+	if digits := query.Get("digits"); digits != "" {
+		if totp.Digits, err = strconv.Atoi(digits); err != nil {
+			return nil, ErrInvalidTOTPDigits
+		}
+	}
+	if period := query.Get("period"); period != "" {
+		if totp.Period, err = strconv.Atoi(period); err != nil {
+			return nil, ErrInvalidTOTPPeriod
+		}
+	}
+
+	if err := totp.Validate(); err != nil {
+		return nil, err
+	}
+	return totp, nil
+}
+
+// Validate checks the secret, algorithm, number of digits and period
+func (t *TOTP) Validate() error {
+	if key, err := t.key(); err != nil || len(key) == 0 {
+		return ErrInvalidTOTPSecret
+	}
+	if t.hash() == nil {
+		return ErrInvalidTOTPAlgorithm
+	}
+	if t.Digits != 6 && t.Digits != 8 {
+		return ErrInvalidTOTPDigits
+	}
+	if t.Period < 1 || t.Period > 300 {
+		return ErrInvalidTOTPPeriod
+	}
+	return nil
+}
+
+// Code returns the one-time password valid at the given time
+func (t *TOTP) Code(now time.Time) (string, error) {
+	if err := t.Validate(); err != nil {
+		return "", err
+	}
+	key, _ := t.key()
+
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(now.Unix())/uint64(t.Period))
+
+	mac := hmac.New(t.hash(), key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation, RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for range t.Digits {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", t.Digits, value%modulo), nil
+}
+
+// Remaining returns the time left until the code valid at now changes
+func (t *TOTP) Remaining(now time.Time) time.Duration {
+	if t.Period < 1 {
+		return 0
+	}
+	period := int64(t.Period)
+	return time.Duration(period-now.Unix()%period) * time.Second
+}
+
+// URI returns the seed as an otpauth URI, e.g. to import it into an authenticator app
+func (t *TOTP) URI() string {
+	label := t.Account
+	if t.Issuer != "" {
+		label = t.Issuer + ":" + t.Account
+	}
+
+	query := url.Values{}
+	query.Set("secret", t.Secret)
+	if t.Issuer != "" {
+		query.Set("issuer", t.Issuer)
+	}
+	query.Set("algorithm", t.Algorithm)
+	query.Set("digits", strconv.Itoa(t.Digits))
+	query.Set("period", strconv.Itoa(t.Period))
+
+	u := url.URL{Scheme: "otpauth", Host: "totp", Path: "/" + label, RawQuery: query.Encode()}
+	return u.String()
+}
+
+// Field returns the value of a field by name (case-insensitive)
+// Supported names: secret, issuer, account, algorithm, digits, period, uri and code (the current code)
+func (t *TOTP) Field(name string) (string, bool) {
+	switch strings.ToLower(name) {
+	case "secret":
+		return t.Secret, true
+	case "issuer":
+		return t.Issuer, true
+	case "account":
+		return t.Account, true
+	case "algorithm":
+		return t.Algorithm, true
+	case "digits":
+		return strconv.Itoa(t.Digits), true
+	case "period":
+		return strconv.Itoa(t.Period), true
+	case "uri":
+		return t.URI(), true
+	case "code":
+		code, err := t.Code(time.Now())
+		return code, err == nil
+	}
+	return "", false
+}
+
+func (t *TOTP) key() ([]byte, error) {
+	return base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(NormalizeTOTPSecret(t.Secret))
+}
+
+func (t *TOTP) hash() func() hash.Hash {
+	switch strings.ToUpper(t.Algorithm) {
+	case "SHA1":
+		return sha1.New
+	case "SHA256":
+		return sha256.New
+	case "SHA512":
+		return sha512.New
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+// Test vectors of RFC 6238 appendix B
+func TestTOTPCodeRFC6238(t *testing.T) {
+	encode := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString
+	seeds := map[string]string{
+		"SHA1":   encode([]byte("12345678901234567890")),
+		"SHA256": encode([]byte("12345678901234567890123456789012")),
+		"SHA512": encode([]byte("1234567890123456789012345678901234567890123456789012345678901234")),
+	}
+
+	tests := []struct {
+		unix      int64
+		algorithm string
+		want      string
+	}{
+		{unix: 59, algorithm: "SHA1", want: "94287082"},
+		{unix: 59, algorithm: "SHA256", want: "46119246"},
+		{unix: 59, algorithm: "SHA512", want: "90693936"},
+		{unix: 1111111109, algorithm: "SHA1", want: "07081804"},
+		{unix: 1111111109, algorithm: "SHA256", want: "68084774"},
+		{unix: 1111111109, algorithm: "SHA512", want: "25091201"},
+		{unix: 1111111111, algorithm: "SHA1", want: "14050471"},
+		{unix: 1111111111, algorithm: "SHA256", want: "67062674"},
+		{unix: 1111111111, algorithm: "SHA512", want: "99943326"},
+		{unix: 1234567890, algorithm: "SHA1", want: "89005924"},
+		{unix: 1234567890, algorithm: "SHA256", want: "91819424"},
+		{unix: 1234567890, algorithm: "SHA512", want: "93441116"},
+		{unix: 2000000000, algorithm: "SHA1", want: "69279037"},
+		{unix: 2000000000, algorithm: "SHA256", want: "90698825"},
+		{unix: 2000000000, algorithm: "SHA512", want: "38618901"},
+		{unix: 20000000000, algorithm: "SHA1", want: "65353130"},
+		{unix: 20000000000, algorithm: "SHA256", want: "77737706"},
+		{unix: 20000000000, algorithm: "SHA512", want: "47863826"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.algorithm+"/"+time.Unix(tt.unix, 0).UTC().Format(time.RFC3339), func(t *testing.T) {
+			totp := &TOTP{Secret: seeds[tt.algorithm], Algorithm: tt.algorithm, Digits: 8, Period: 30}
+			got, err := totp.Code(time.Unix(tt.unix, 0))
+			if err != nil {
+				t.Fatalf("Code() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Code() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTOTPCodeDefaults(t *testing.T) {
+	tests := []struct {
+		name string
+		unix int64
+		want string
+	}{
+		{name: "six digits keep leading zeros", unix: 1111111109, want: "081804"},
+		{name: "start of the same period", unix: 1111111080, want: "081804"},
+		{name: "next period", unix: 1111111111, want: "050471"},
+	}
+
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTOTP(secret).Code(time.Unix(tt.unix, 0))
+			if err != nil {
+				t.Fatalf("Code() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Code() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+)
+
+// SortField is a resource column listings can be sorted by
+type SortField string
+
+const (
+	SortByName      SortField = "name" // legacy only: encrypted names are sorted by their blind index
+	SortByCreatedAt SortField = "created_at"
+	SortByUpdatedAt SortField = "updated_at"
+	SortBySize      SortField = "size"
+)
+
+// IsValid checks if resources can be sorted by the field
+func (f SortField) IsValid() bool {
+	switch f {
+	case SortByName, SortByCreatedAt, SortByUpdatedAt, SortBySize:
+		return true
+	default:
+		return false
+	}
+}
+
+// Cursor points at the last resource of the previous page
+type Cursor struct {
+	Value any // value of the sort column: string for name, time.Time for dates, int64 for size
+	ID    int64
+}
+
+// CursorFor returns a cursor pointing at the resource for the given sort order
+func CursorFor(resource *models.Resource, sortBy SortField) Cursor {
+	cursor := Cursor{ID: resource.ID}
+	switch sortBy {
+	case SortByName:
+		cursor.Value = resource.Name
+	case SortByUpdatedAt:
+		cursor.Value = resource.UpdatedAt
+	case SortBySize:
+		cursor.Value = resource.Size
+	default:
+		cursor.Value = resource.CreatedAt
+	}
+	return cursor
+}
+
+// ListOptions filters, sorts and paginates resources of a user
+// Zero values disable the corresponding filter
+type ListOptions struct {
+	Type          models.ResourceType
+	PathPrefix    string // legacy only: folder of plaintext names, resources with encrypted names never match
+	NamePrefix    string // legacy only: beginning of a plaintext name, resources with encrypted names never match
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+
+	SortBy     SortField
+	Descending bool
+
+	Limit int     // maximum number of resources, 0 for no limit
+	After *Cursor // return resources following the cursor in the sort order
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
 )
@@ -11,11 +12,52 @@ type ResourceRepository interface {
 
 	GetByID(ctx context.Context, id int64) (*models.Resource, error)
 
-	GetByUserID(ctx context.Context, userID int64) ([]*models.Resource, error)
+	// List returns resources of the user without their data
+	List(ctx context.Context, userID int64, opts ListOptions) ([]*models.Resource, error)
 
 	GetByNameAndUserID(ctx context.Context, userID int64, name string) (*models.Resource, error)
 
-	Update(ctx context.Context, resource *models.Resource) error
+	// Update replaces the resource, rotated_at is advanced only if rotated is set
+	Update(ctx context.Context, resource *models.Resource, rotated bool) error
+
+	// UpdateAttributes updates everything except the stored data
+	UpdateAttributes(ctx context.Context, resource *models.Resource) error
+
+	Rename(ctx context.Context, resource *models.Resource) error
+
+	MoveFolder(ctx context.Context, userID int64, from, to string) (int64, error)
 
 	Delete(ctx context.Context, id int64) error
+
+	SoftDelete(ctx context.Context, id, revision int64) error
+
+	Restore(ctx context.Context, id int64) error
+
+	GetDeletedByID(ctx context.Context, id int64) (*models.Resource, error)
+
+	GetDeletedByUserID(ctx context.Context, userID int64) ([]*models.Resource, error)
+
+	// GetDeletedBefore returns at most limit resources of all users moved to trash before the given time,
+	// the longest deleted first, starting after the resource deleted at afterDeletedAt with afterID
+	GetDeletedBefore(ctx context.Context, before, afterDeletedAt time.Time, afterID int64, limit int) ([]*models.Resource, error)
+
+	// ListExpiring returns live resources of the user that expire or are due for rotation before the given time,
+	// without their data, the earliest due first
+	ListExpiring(ctx context.Context, userID int64, before time.Time) ([]*models.Resource, error)
+
+	CreateAttachment(ctx context.Context, attachment *models.Attachment) (*models.Attachment, error)
+
+	GetAttachmentByID(ctx context.Context, id int64) (*models.Attachment, error)
+
+	// ListAttachments returns attachments of the resource without their data
+	ListAttachments(ctx context.Context, resourceID int64) ([]*models.Attachment, error)
+
+	DeleteAttachment(ctx context.Context, id int64) error
+
+	CreateType(ctx context.Context, userID int64, definition *models.TypeDefinition) error
+
+	// ListTypes returns resource types defined by the user, built-in types are not stored
+	ListTypes(ctx context.Context, userID int64) ([]*models.TypeDefinition, error)
+
+	DeleteType(ctx context.Context, userID int64, name string) error
 }
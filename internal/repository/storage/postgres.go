@@ -3,10 +3,16 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/repository"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -15,7 +21,12 @@ type PostgresResourceRepository struct {
 }
 
 var (
-	ErrResourceNotFound = errors.New("resource not found")
+	ErrResourceNotFound   = errors.New("resource not found")
+	ErrRevisionMismatch   = errors.New("resource revision mismatch")
+	ErrResourceExists     = errors.New("resource with this name already exists")
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	ErrTypeNotFound       = errors.New("resource type not found")
+	ErrTypeExists         = errors.New("resource type with this name already exists")
 )
 
 func NewPostgresResourceRepository(dsn string) (*PostgresResourceRepository, error) {
@@ -28,23 +39,29 @@ func NewPostgresResourceRepository(dsn string) (*PostgresResourceRepository, err
 
 func (r *PostgresResourceRepository) Create(ctx context.Context, resource *models.Resource) (*models.Resource, error) {
 	query := `
-        INSERT INTO resources (user_id, name, type, storage, object_key, size, metadata, data)
-        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-        RETURNING id, created_at, updated_at
+        INSERT INTO resources (user_id, name, encrypted_name, type, storage, object_key, size, metadata, data, expires_at, rotate_every)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+        RETURNING id, revision, created_at, updated_at, rotated_at
     `
 
 	err := r.db.QueryRowxContext(ctx, query,
 		resource.UserID,
 		resource.Name,
+		resource.EncryptedName,
 		resource.Type,
 		resource.Storage,
 		resource.ObjectKey,
 		resource.Size,
 		resource.Metadata,
 		resource.Data,
-	).Scan(&resource.ID, &resource.CreatedAt, &resource.UpdatedAt)
+		resource.ExpiresAt,
+		resource.RotateEvery,
+	).Scan(&resource.ID, &resource.Revision, &resource.CreatedAt, &resource.UpdatedAt, &resource.RotatedAt)
 
 	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrResourceExists
+		}
 		return nil, fmt.Errorf("failed to create resource: %w", err)
 	}
 
@@ -53,9 +70,9 @@ func (r *PostgresResourceRepository) Create(ctx context.Context, resource *model
 
 func (r *PostgresResourceRepository) GetByID(ctx context.Context, id int64) (*models.Resource, error) {
 	query := `
-        SELECT id, user_id, name, type, storage, object_key, size, metadata, data, created_at, updated_at
+        SELECT id, user_id, name, encrypted_name, type, storage, object_key, size, metadata, data, revision, created_at, updated_at, deleted_at, expires_at, rotate_every, rotated_at
         FROM resources
-        WHERE id = $1
+        WHERE id = $1 AND deleted_at IS NULL
     `
 
 	var resource models.Resource
@@ -70,18 +87,65 @@ func (r *PostgresResourceRepository) GetByID(ctx context.Context, id int64) (*mo
 	return &resource, nil
 }
 
-func (r *PostgresResourceRepository) GetByUserID(ctx context.Context, userID int64) ([]*models.Resource, error) {
-	query := `
-		SELECT id, user_id, name, type, storage, object_key, size, metadata, data, created_at, updated_at
+// List returns live resources of the user matching opts. The data column is never selected
+func (r *PostgresResourceRepository) List(ctx context.Context, userID int64, opts repository.ListOptions) ([]*models.Resource, error) {
+	conditions := []string{"user_id = $1", "deleted_at IS NULL"}
+	args := []any{userID}
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if opts.Type != "" {
+		conditions = append(conditions, "type = "+arg(opts.Type))
+	}
+	if opts.PathPrefix != "" {
+		conditions = append(conditions, "name LIKE "+arg(folderPattern(opts.PathPrefix)))
+	}
+	if opts.NamePrefix != "" {
+		conditions = append(conditions, "name LIKE "+arg(escapeLike(opts.NamePrefix)+"%"))
+	}
+	if opts.CreatedAfter != nil {
+		conditions = append(conditions, "created_at >= "+arg(*opts.CreatedAfter))
+	}
+	if opts.CreatedBefore != nil {
+		conditions = append(conditions, "created_at < "+arg(*opts.CreatedBefore))
+	}
+	if opts.UpdatedAfter != nil {
+		conditions = append(conditions, "updated_at >= "+arg(*opts.UpdatedAfter))
+	}
+	if opts.UpdatedBefore != nil {
+		conditions = append(conditions, "updated_at < "+arg(*opts.UpdatedBefore))
+	}
+
+	sortColumn := string(repository.SortByCreatedAt)
+	if opts.SortBy.IsValid() {
+		sortColumn = string(opts.SortBy)
+	}
+	direction, comparison := "ASC", ">"
+	if opts.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	// Keyset pagination: id breaks ties so the order is stable across pages
+	if opts.After != nil {
+		conditions = append(conditions, fmt.Sprintf("(%s, id) %s (%s, %s)", sortColumn, comparison, arg(opts.After.Value), arg(opts.After.ID)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, user_id, name, encrypted_name, type, storage, object_key, size, metadata, revision, created_at, updated_at, deleted_at, expires_at, rotate_every, rotated_at
 		FROM resources
-		WHERE user_id = $1
-		ORDER BY created_at DESC
-	`
+		WHERE %s
+		ORDER BY %s %s, id %s
+	`, strings.Join(conditions, " AND "), sortColumn, direction, direction)
+	if opts.Limit > 0 {
+		query += "LIMIT " + arg(opts.Limit)
+	}
 
 	var resources []*models.Resource
-	err := r.db.SelectContext(ctx, &resources, query, userID)
+	err := r.db.SelectContext(ctx, &resources, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get resources: %w", err)
+		return nil, fmt.Errorf("failed to list resources: %w", err)
 	}
 
 	return resources, nil
@@ -89,9 +153,9 @@ func (r *PostgresResourceRepository) GetByUserID(ctx context.Context, userID int
 
 func (r *PostgresResourceRepository) GetByNameAndUserID(ctx context.Context, userID int64, name string) (*models.Resource, error) {
 	query := `
-		SELECT id, user_id, name, type, storage, object_key, size, metadata, data, created_at, updated_at
+		SELECT id, user_id, name, encrypted_name, type, storage, object_key, size, metadata, data, revision, created_at, updated_at, deleted_at, expires_at, rotate_every, rotated_at
 		FROM resources
-		WHERE user_id = $1 AND name = $2
+		WHERE user_id = $1 AND name = $2 AND deleted_at IS NULL
 	`
 
 	var resource models.Resource
@@ -105,20 +169,116 @@ func (r *PostgresResourceRepository) GetByNameAndUserID(ctx context.Context, use
 	return &resource, nil
 }
 
-func (r *PostgresResourceRepository) Update(ctx context.Context, resource *models.Resource) error {
+// Update updates the resource only if its current revision equals resource.Revision.
+// The resource counts as rotated only if rotated is set: rewriting the data without
+// changing the secret value, e.g. editing notes of a credential, keeps rotated_at.
+// On success resource.Revision, resource.UpdatedAt and resource.RotatedAt are set to the new values
+func (r *PostgresResourceRepository) Update(ctx context.Context, resource *models.Resource, rotated bool) error {
 	query := `
 		UPDATE resources
-		SET name = $1, type = $2, storage = $3, object_key = $4, size = $5, metadata = $6, data = $7
-		WHERE id = $8
+		SET name = $1, encrypted_name = $2, type = $3, storage = $4, object_key = $5, size = $6, metadata = $7, data = $8,
+			expires_at = $9, rotate_every = $10, revision = revision + 1, updated_at = NOW(),
+			rotated_at = CASE WHEN $13 THEN NOW() ELSE rotated_at END
+		WHERE id = $11 AND revision = $12 AND deleted_at IS NULL
+		RETURNING revision, updated_at, rotated_at
 	`
 
-	_, err := r.db.ExecContext(ctx, query, resource.Name, resource.Type, resource.Storage, resource.ObjectKey, resource.Size, resource.Metadata, resource.Data, resource.ID)
+	err := r.db.QueryRowxContext(ctx, query, resource.Name, resource.EncryptedName, resource.Type, resource.Storage, resource.ObjectKey, resource.Size, resource.Metadata, resource.Data,
+		resource.ExpiresAt, resource.RotateEvery, resource.ID, resource.Revision, rotated).
+		Scan(&resource.Revision, &resource.UpdatedAt, &resource.RotatedAt)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrRevisionMismatch
+		}
+		if isUniqueViolation(err) {
+			return ErrResourceExists
+		}
 		return fmt.Errorf("failed to update resource: %w", err)
 	}
 	return nil
 }
 
+// UpdateAttributes updates the name, type, metadata, expiry and rotation interval of the resource only if
+// its current revision equals resource.Revision. Storage columns and data are not touched.
+// On success resource.Revision and resource.UpdatedAt are set to the new values
+func (r *PostgresResourceRepository) UpdateAttributes(ctx context.Context, resource *models.Resource) error {
+	query := `
+		UPDATE resources
+		SET name = $1, encrypted_name = $2, type = $3, metadata = $4, expires_at = $5, rotate_every = $6,
+			revision = revision + 1, updated_at = NOW()
+		WHERE id = $7 AND revision = $8 AND deleted_at IS NULL
+		RETURNING revision, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query, resource.Name, resource.EncryptedName, resource.Type, resource.Metadata,
+		resource.ExpiresAt, resource.RotateEvery, resource.ID, resource.Revision).
+		Scan(&resource.Revision, &resource.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrRevisionMismatch
+		}
+		if isUniqueViolation(err) {
+			return ErrResourceExists
+		}
+		return fmt.Errorf("failed to update resource attributes: %w", err)
+	}
+	return nil
+}
+
+// Rename changes the resource name only if its current revision equals resource.Revision.
+// On success resource.Revision and resource.UpdatedAt are set to the new values
+func (r *PostgresResourceRepository) Rename(ctx context.Context, resource *models.Resource) error {
+	query := `
+		UPDATE resources
+		SET name = $1, encrypted_name = $2, revision = revision + 1, updated_at = NOW()
+		WHERE id = $3 AND revision = $4 AND deleted_at IS NULL
+		RETURNING revision, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query, resource.Name, resource.EncryptedName, resource.ID, resource.Revision).
+		Scan(&resource.Revision, &resource.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrRevisionMismatch
+		}
+		if isUniqueViolation(err) {
+			return ErrResourceExists
+		}
+		return fmt.Errorf("failed to rename resource: %w", err)
+	}
+	return nil
+}
+
+// MoveFolder moves all live resources of the user from the folder from into the folder to
+// (the empty to denotes the root folder). The move is atomic: if any resulting name is taken, nothing is moved.
+// Resources with encrypted names are not affected, their folders are only known to the client
+func (r *PostgresResourceRepository) MoveFolder(ctx context.Context, userID int64, from, to string) (int64, error) {
+	query := `
+		UPDATE resources
+		SET name = $2 || SUBSTRING(name FROM $3), revision = revision + 1, updated_at = NOW()
+		WHERE user_id = $1 AND deleted_at IS NULL AND encrypted_name IS NULL AND name LIKE $4
+	`
+
+	newPrefix := ""
+	if to != "" {
+		newPrefix = to + models.PathSeparator
+	}
+	// SUBSTRING counts characters from 1, skip "from/" to keep the relative part of the name
+	res, err := r.db.ExecContext(ctx, query, userID, newPrefix, utf8.RuneCountInString(from)+2, folderPattern(from))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, ErrResourceExists
+		}
+		return 0, fmt.Errorf("failed to move folder: %w", err)
+	}
+
+	moved, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to move folder: %w", err)
+	}
+	return moved, nil
+}
+
 func (r *PostgresResourceRepository) Delete(ctx context.Context, id int64) error {
 	query := `
 		DELETE FROM resources
@@ -131,3 +291,278 @@ func (r *PostgresResourceRepository) Delete(ctx context.Context, id int64) error
 	}
 	return nil
 }
+
+// SoftDelete moves the resource to trash only if its current revision equals revision
+func (r *PostgresResourceRepository) SoftDelete(ctx context.Context, id, revision int64) error {
+	query := `
+		UPDATE resources
+		SET deleted_at = NOW(), revision = revision + 1
+		WHERE id = $1 AND revision = $2 AND deleted_at IS NULL
+	`
+
+	res, err := r.db.ExecContext(ctx, query, id, revision)
+	if err != nil {
+		return fmt.Errorf("failed to move resource to trash: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrRevisionMismatch
+	}
+	return nil
+}
+
+func (r *PostgresResourceRepository) Restore(ctx context.Context, id int64) error {
+	query := `
+		UPDATE resources
+		SET deleted_at = NULL, revision = revision + 1
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`
+
+	res, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrResourceExists
+		}
+		return fmt.Errorf("failed to restore resource: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrResourceNotFound
+	}
+	return nil
+}
+
+func (r *PostgresResourceRepository) GetDeletedByID(ctx context.Context, id int64) (*models.Resource, error) {
+	query := `
+		SELECT id, user_id, name, encrypted_name, type, storage, object_key, size, metadata, data, revision, created_at, updated_at, deleted_at, expires_at, rotate_every, rotated_at
+		FROM resources
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`
+
+	var resource models.Resource
+	err := r.db.GetContext(ctx, &resource, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("failed to get deleted resource: %w", err)
+	}
+
+	return &resource, nil
+}
+
+func (r *PostgresResourceRepository) GetDeletedByUserID(ctx context.Context, userID int64) ([]*models.Resource, error) {
+	query := `
+		SELECT id, user_id, name, encrypted_name, type, storage, object_key, size, metadata, revision, created_at, updated_at, deleted_at, expires_at, rotate_every, rotated_at
+		FROM resources
+		WHERE user_id = $1 AND deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC
+	`
+
+	var resources []*models.Resource
+	err := r.db.SelectContext(ctx, &resources, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted resources: %w", err)
+	}
+
+	return resources, nil
+}
+
+// GetDeletedBefore returns a batch of resources of all users that were moved to trash before the given time
+// Resources are ordered by deletion time and ID, the batch starts after (afterDeletedAt, afterID)
+func (r *PostgresResourceRepository) GetDeletedBefore(ctx context.Context, before, afterDeletedAt time.Time, afterID int64, limit int) ([]*models.Resource, error) {
+	query := `
+		SELECT id, user_id, name, encrypted_name, type, storage, object_key, size, metadata, revision, created_at, updated_at, deleted_at, expires_at, rotate_every, rotated_at
+		FROM resources
+		WHERE deleted_at IS NOT NULL AND deleted_at < $1 AND (deleted_at, id) > ($2, $3)
+		ORDER BY deleted_at, id
+		LIMIT $4
+	`
+
+	var resources []*models.Resource
+	err := r.db.SelectContext(ctx, &resources, query, before, afterDeletedAt, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired deleted resources: %w", err)
+	}
+
+	return resources, nil
+}
+
+func (r *PostgresResourceRepository) ListExpiring(ctx context.Context, userID int64, before time.Time) ([]*models.Resource, error) {
+	query := `
+		SELECT id, user_id, name, encrypted_name, type, storage, object_key, size, metadata, revision, created_at, updated_at, deleted_at, expires_at, rotate_every, rotated_at
+		FROM resources
+		WHERE user_id = $1 AND deleted_at IS NULL AND (
+			expires_at < $2 OR (rotate_every > 0 AND rotated_at + rotate_every * INTERVAL '1 second' < $2)
+		)
+		ORDER BY LEAST(expires_at, CASE WHEN rotate_every > 0 THEN rotated_at + rotate_every * INTERVAL '1 second' END), id
+	`
+
+	var resources []*models.Resource
+	err := r.db.SelectContext(ctx, &resources, query, userID, before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expiring resources: %w", err)
+	}
+
+	return resources, nil
+}
+
+func (r *PostgresResourceRepository) CreateAttachment(ctx context.Context, attachment *models.Attachment) (*models.Attachment, error) {
+	query := `
+		INSERT INTO attachments (resource_id, user_id, encrypted_name, storage, object_key, size, metadata, data)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		attachment.ResourceID,
+		attachment.UserID,
+		attachment.EncryptedName,
+		attachment.Storage,
+		attachment.ObjectKey,
+		attachment.Size,
+		attachment.Metadata,
+		attachment.Data,
+	).Scan(&attachment.ID, &attachment.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	return attachment, nil
+}
+
+func (r *PostgresResourceRepository) GetAttachmentByID(ctx context.Context, id int64) (*models.Attachment, error) {
+	query := `
+		SELECT id, resource_id, user_id, encrypted_name, storage, object_key, size, metadata, data, created_at
+		FROM attachments
+		WHERE id = $1
+	`
+
+	var attachment models.Attachment
+	err := r.db.GetContext(ctx, &attachment, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	return &attachment, nil
+}
+
+// ListAttachments returns attachments of the resource in the order they were added. The data column is never selected
+func (r *PostgresResourceRepository) ListAttachments(ctx context.Context, resourceID int64) ([]*models.Attachment, error) {
+	query := `
+		SELECT id, resource_id, user_id, encrypted_name, storage, object_key, size, metadata, created_at
+		FROM attachments
+		WHERE resource_id = $1
+		ORDER BY id
+	`
+
+	var attachments []*models.Attachment
+	err := r.db.SelectContext(ctx, &attachments, query, resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+func (r *PostgresResourceRepository) DeleteAttachment(ctx context.Context, id int64) error {
+	query := `
+		DELETE FROM attachments
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+	return nil
+}
+
+// typeRow is a row of resource_types, fields are stored as JSON
+type typeRow struct {
+	Name        string `db:"name"`
+	Description string `db:"description"`
+	Fields      []byte `db:"fields"`
+}
+
+func (r *PostgresResourceRepository) CreateType(ctx context.Context, userID int64, definition *models.TypeDefinition) error {
+	fields, err := json.Marshal(definition.Fields)
+	if err != nil {
+		return fmt.Errorf("failed to encode fields: %w", err)
+	}
+
+	query := `
+		INSERT INTO resource_types (user_id, name, description, fields)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	_, err = r.db.ExecContext(ctx, query, userID, definition.Name, definition.Description, fields)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrTypeExists
+		}
+		return fmt.Errorf("failed to create resource type: %w", err)
+	}
+	return nil
+}
+
+// ListTypes returns resource types defined by the user ordered by name
+func (r *PostgresResourceRepository) ListTypes(ctx context.Context, userID int64) ([]*models.TypeDefinition, error) {
+	query := `
+		SELECT name, description, fields
+		FROM resource_types
+		WHERE user_id = $1
+		ORDER BY name
+	`
+
+	var rows []typeRow
+	if err := r.db.SelectContext(ctx, &rows, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to list resource types: %w", err)
+	}
+
+	definitions := make([]*models.TypeDefinition, 0, len(rows))
+	for _, row := range rows {
+		definition := &models.TypeDefinition{Name: row.Name, Description: row.Description}
+		if err := json.Unmarshal(row.Fields, &definition.Fields); err != nil {
+			return nil, fmt.Errorf("failed to decode fields of resource type %s: %w", row.Name, err)
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions, nil
+}
+
+func (r *PostgresResourceRepository) DeleteType(ctx context.Context, userID int64, name string) error {
+	query := `
+		DELETE FROM resource_types
+		WHERE user_id = $1 AND name = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, userID, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete resource type: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rows == 0 {
+		return ErrTypeNotFound
+	}
+	return nil
+}
+
+// folderPattern returns a LIKE pattern matching names inside the folder at any depth
+func folderPattern(folder string) string {
+	return escapeLike(folder) + models.PathSeparator + "%"
+}
+
+// escapeLike escapes LIKE wildcards so that s is matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
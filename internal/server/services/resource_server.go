@@ -3,9 +3,13 @@ package services
 import (
 	"context"
 	"errors"
+	"slices"
+	"time"
 
 	pb "github.com/OvsienkoValeriya/GophKeeper/api/gen"
 	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/repository"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/repository/storage"
 	"github.com/OvsienkoValeriya/GophKeeper/internal/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -30,12 +34,30 @@ func (s *ResourceServer) CreateResource(ctx context.Context, req *pb.CreateResou
 	}
 
 	resourceType := models.ResourceType(req.GetType())
-	if !isValidResourceType(resourceType) {
-		return nil, status.Error(codes.InvalidArgument, "invalid resource type")
+	if err := s.checkResourceType(ctx, userID, resourceType); err != nil {
+		return nil, err
 	}
 
-	resource, err := s.resourceService.Upload(ctx, userID, req.GetName(), resourceType, req.GetData())
+	expiresAt, err := parseOptionalTime("expires_at", req.GetExpiresAt())
 	if err != nil {
+		return nil, err
+	}
+
+	resource, err := s.resourceService.Upload(ctx, userID, req.GetName(), req.GetEncryptedName(), resourceType, req.GetData(), req.GetMetadata(),
+		expiresAt, req.GetRotateEvery())
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRotation) {
+			return nil, status.Error(codes.InvalidArgument, "rotation interval must not be negative")
+		}
+		if errors.Is(err, models.ErrInvalidResourceName) {
+			return nil, status.Error(codes.InvalidArgument, "invalid resource name")
+		}
+		if errors.Is(err, service.ErrMetadataTooLarge) {
+			return nil, status.Error(codes.InvalidArgument, "resource metadata is too large")
+		}
+		if errors.Is(err, service.ErrResourceExists) {
+			return nil, status.Error(codes.AlreadyExists, "resource with this name already exists")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to create resource: %v", err)
 	}
 
@@ -45,6 +67,7 @@ func (s *ResourceServer) CreateResource(ctx context.Context, req *pb.CreateResou
 		Type:      proto.String(string(resource.Type)),
 		Size:      proto.Int64(resource.Size),
 		CreatedAt: proto.String(resource.CreatedAt.Format("2006-01-02T15:04:05Z")),
+		Revision:  proto.Int64(resource.Revision),
 	}, nil
 }
 
@@ -65,15 +88,18 @@ func (s *ResourceServer) GetResource(ctx context.Context, req *pb.GetResourceReq
 		return nil, status.Errorf(codes.Internal, "failed to get resource: %v", err)
 	}
 
-	return &pb.GetResourceResponse{
-		Id:        proto.Int64(resource.ID),
-		Name:      proto.String(resource.Name),
-		Type:      proto.String(string(resource.Type)),
-		Data:      data,
-		Size:      proto.Int64(resource.Size),
-		CreatedAt: proto.String(resource.CreatedAt.Format("2006-01-02T15:04:05Z")),
-		UpdatedAt: proto.String(resource.UpdatedAt.Format("2006-01-02T15:04:05Z")),
-	}, nil
+	return withExpiry(&pb.GetResourceResponse{
+		Id:            proto.Int64(resource.ID),
+		Name:          proto.String(resource.Name),
+		EncryptedName: resource.EncryptedName,
+		Type:          proto.String(string(resource.Type)),
+		Data:          data,
+		Size:          proto.Int64(resource.Size),
+		Metadata:      resource.Metadata,
+		CreatedAt:     proto.String(resource.CreatedAt.Format("2006-01-02T15:04:05Z")),
+		UpdatedAt:     proto.String(resource.UpdatedAt.Format("2006-01-02T15:04:05Z")),
+		Revision:      proto.Int64(resource.Revision),
+	}, resource), nil
 }
 
 func (s *ResourceServer) GetResourceByName(ctx context.Context, req *pb.GetResourceByNameRequest) (*pb.GetResourceResponse, error) {
@@ -82,7 +108,7 @@ func (s *ResourceServer) GetResourceByName(ctx context.Context, req *pb.GetResou
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	resource, data, err := s.resourceService.GetByName(ctx, userID, req.GetName())
+	resource, data, err := s.resourceService.GetByName(ctx, userID, req.GetName(), !req.GetWithoutData())
 	if err != nil {
 		if errors.Is(err, service.ErrAccessDenied) {
 			return nil, status.Error(codes.PermissionDenied, "access denied")
@@ -93,64 +119,561 @@ func (s *ResourceServer) GetResourceByName(ctx context.Context, req *pb.GetResou
 		return nil, status.Errorf(codes.Internal, "failed to get resource: %v", err)
 	}
 
-	return &pb.GetResourceResponse{
+	return withExpiry(&pb.GetResourceResponse{
+		Id:            proto.Int64(resource.ID),
+		Name:          proto.String(resource.Name),
+		EncryptedName: resource.EncryptedName,
+		Type:          proto.String(string(resource.Type)),
+		Data:          data,
+		Size:          proto.Int64(resource.Size),
+		Metadata:      resource.Metadata,
+		CreatedAt:     proto.String(resource.CreatedAt.Format("2006-01-02T15:04:05Z")),
+		UpdatedAt:     proto.String(resource.UpdatedAt.Format("2006-01-02T15:04:05Z")),
+		Revision:      proto.Int64(resource.Revision),
+	}, resource), nil
+}
+
+// ListResources filters by path and name prefix and sorts by name on plaintext names only,
+// resources with encrypted names are filtered and sorted by name on the client
+func (s *ResourceServer) ListResources(ctx context.Context, req *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	opts := repository.ListOptions{
+		Type:       models.ResourceType(req.GetType()),
+		PathPrefix: req.GetPathPrefix(),
+		NamePrefix: req.GetNamePrefix(),
+		SortBy:     repository.SortField(req.GetSortBy()),
+		Descending: req.GetSortDesc(),
+	}
+	if opts.Type != "" {
+		if err := s.checkResourceType(ctx, userID, opts.Type); err != nil {
+			return nil, err
+		}
+	}
+
+	timeFilters := []struct {
+		name  string
+		value string
+		dest  **time.Time
+	}{
+		{"created_after", req.GetCreatedAfter(), &opts.CreatedAfter},
+		{"created_before", req.GetCreatedBefore(), &opts.CreatedBefore},
+		{"updated_after", req.GetUpdatedAfter(), &opts.UpdatedAfter},
+		{"updated_before", req.GetUpdatedBefore(), &opts.UpdatedBefore},
+	}
+	for _, f := range timeFilters {
+		if f.value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, f.value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s: expected RFC 3339 time", f.name)
+		}
+		t = t.UTC()
+		*f.dest = &t
+	}
+
+	resources, nextPageToken, err := s.resourceService.List(ctx, userID, opts, int(req.GetPageSize()), req.GetPageToken())
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrInvalidResourceName):
+			return nil, status.Error(codes.InvalidArgument, "invalid path prefix")
+		case errors.Is(err, service.ErrInvalidSortField):
+			return nil, status.Error(codes.InvalidArgument, "invalid sort field: use name, created_at, updated_at or size")
+		case errors.Is(err, service.ErrInvalidPageToken):
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list resources: %v", err)
+	}
+
+	pbResources := make([]*pb.GetResourceResponse, len(resources))
+	for i, r := range resources {
+		pbResources[i] = withExpiry(&pb.GetResourceResponse{
+			Id:            proto.Int64(r.ID),
+			Name:          proto.String(r.Name),
+			EncryptedName: r.EncryptedName,
+			Type:          proto.String(string(r.Type)),
+			Size:          proto.Int64(r.Size),
+			Metadata:      r.Metadata,
+			CreatedAt:     proto.String(r.CreatedAt.Format("2006-01-02T15:04:05Z")),
+			UpdatedAt:     proto.String(r.UpdatedAt.Format("2006-01-02T15:04:05Z")),
+			Revision:      proto.Int64(r.Revision),
+		}, r)
+	}
+
+	return &pb.ListResourcesResponse{
+		Resources:     pbResources,
+		NextPageToken: proto.String(nextPageToken),
+	}, nil
+}
+
+func (s *ResourceServer) UpdateResource(ctx context.Context, req *pb.UpdateResourceRequest) (*pb.UpdateResourceResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if req.ExpectedRevision == nil {
+		return nil, status.Error(codes.InvalidArgument, "expected revision is required")
+	}
+
+	resourceType := models.ResourceType(req.GetType())
+
+	// Without a mask the whole resource is replaced, with it only the listed fields
+	var resource *models.Resource
+	if req.UpdateMask == nil {
+		if err := s.checkResourceType(ctx, userID, resourceType); err != nil {
+			return nil, err
+		}
+		resource, err = s.resourceService.Update(ctx, userID, req.GetId(), req.GetExpectedRevision(), req.GetName(), req.GetEncryptedName(),
+			resourceType, req.GetData(), req.GetMetadata())
+	} else {
+		paths := req.GetUpdateMask().GetPaths()
+		if slices.Contains(paths, service.FieldType) {
+			if err := s.checkResourceType(ctx, userID, resourceType); err != nil {
+				return nil, err
+			}
+		}
+		expiresAt, err := parseOptionalTime("expires_at", req.GetExpiresAt())
+		if err != nil {
+			return nil, err
+		}
+		changes := &models.Resource{
+			Name:          req.GetName(),
+			EncryptedName: req.GetEncryptedName(),
+			Type:          resourceType,
+			Metadata:      req.GetMetadata(),
+			Data:          req.GetData(),
+			ExpiresAt:     expiresAt,
+			RotateEvery:   req.GetRotateEvery(),
+		}
+		resource, err = s.resourceService.UpdateFields(ctx, userID, req.GetId(), req.GetExpectedRevision(), changes, paths)
+	}
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidUpdateMask) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v: use name, type, metadata, data, rotated, expires_at or rotate_every", err)
+		}
+		if errors.Is(err, service.ErrInvalidRotation) {
+			return nil, status.Error(codes.InvalidArgument, "rotation interval must not be negative")
+		}
+		return nil, revisionAwareError(err, "failed to update resource")
+	}
+
+	return &pb.UpdateResourceResponse{
 		Id:        proto.Int64(resource.ID),
 		Name:      proto.String(resource.Name),
-		Type:      proto.String(string(resource.Type)),
-		Data:      data,
-		Size:      proto.Int64(resource.Size),
-		CreatedAt: proto.String(resource.CreatedAt.Format("2006-01-02T15:04:05Z")),
 		UpdatedAt: proto.String(resource.UpdatedAt.Format("2006-01-02T15:04:05Z")),
+		Revision:  proto.Int64(resource.Revision),
 	}, nil
 }
 
-func (s *ResourceServer) ListResources(ctx context.Context, req *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
+func (s *ResourceServer) RenameResource(ctx context.Context, req *pb.RenameResourceRequest) (*pb.RenameResourceResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if req.ExpectedRevision == nil {
+		return nil, status.Error(codes.InvalidArgument, "expected revision is required")
+	}
+
+	resource, err := s.resourceService.Rename(ctx, userID, req.GetId(), req.GetExpectedRevision(), req.GetNewName(), req.GetEncryptedName())
+	if err != nil {
+		return nil, revisionAwareError(err, "failed to rename resource")
+	}
+
+	return &pb.RenameResourceResponse{
+		Id:       proto.Int64(resource.ID),
+		Name:     proto.String(resource.Name),
+		Revision: proto.Int64(resource.Revision),
+	}, nil
+}
+
+// MoveFolder moves resources with plaintext names only, kept for clients predating encrypted names
+func (s *ResourceServer) MoveFolder(ctx context.Context, req *pb.MoveFolderRequest) (*pb.MoveFolderResponse, error) {
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	resources, err := s.resourceService.GetAll(ctx, userID)
+	moved, err := s.resourceService.MoveFolder(ctx, userID, req.GetFrom(), req.GetTo())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list resources: %v", err)
+		if errors.Is(err, models.ErrInvalidResourceName) {
+			return nil, status.Error(codes.InvalidArgument, "invalid folder path")
+		}
+		if errors.Is(err, service.ErrResourceExists) {
+			return nil, status.Error(codes.AlreadyExists, "destination folder already contains resources with the same names")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to move folder: %v", err)
+	}
+
+	return &pb.MoveFolderResponse{
+		Moved: proto.Int64(moved),
+	}, nil
+}
+
+func (s *ResourceServer) DeleteResource(ctx context.Context, req *pb.DeleteResourceRequest) (*pb.DeleteResourceResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if req.ExpectedRevision == nil {
+		return nil, status.Error(codes.InvalidArgument, "expected revision is required")
+	}
+
+	err = s.resourceService.Delete(ctx, userID, req.GetId(), req.GetExpectedRevision())
+	if err != nil {
+		return nil, revisionAwareError(err, "failed to delete resource")
+	}
+
+	return &pb.DeleteResourceResponse{
+		Success: proto.Bool(true),
+	}, nil
+}
+
+func (s *ResourceServer) ListTrash(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	resources, err := s.resourceService.ListTrash(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list trash: %v", err)
 	}
 
 	pbResources := make([]*pb.GetResourceResponse, len(resources))
 	for i, r := range resources {
-		pbResources[i] = &pb.GetResourceResponse{
-			Id:        proto.Int64(r.ID),
-			Name:      proto.String(r.Name),
-			Type:      proto.String(string(r.Type)),
-			Size:      proto.Int64(r.Size),
-			CreatedAt: proto.String(r.CreatedAt.Format("2006-01-02T15:04:05Z")),
-			UpdatedAt: proto.String(r.UpdatedAt.Format("2006-01-02T15:04:05Z")),
-		}
+		pbResources[i] = withExpiry(&pb.GetResourceResponse{
+			Id:            proto.Int64(r.ID),
+			Name:          proto.String(r.Name),
+			EncryptedName: r.EncryptedName,
+			Type:          proto.String(string(r.Type)),
+			Size:          proto.Int64(r.Size),
+			Metadata:      r.Metadata,
+			CreatedAt:     proto.String(r.CreatedAt.Format("2006-01-02T15:04:05Z")),
+			UpdatedAt:     proto.String(r.UpdatedAt.Format("2006-01-02T15:04:05Z")),
+			DeletedAt:     proto.String(r.DeletedAt.Format("2006-01-02T15:04:05Z")),
+			Revision:      proto.Int64(r.Revision),
+		}, r)
 	}
 
-	return &pb.ListResourcesResponse{
+	return &pb.ListTrashResponse{
 		Resources: pbResources,
 	}, nil
 }
 
-func (s *ResourceServer) DeleteResource(ctx context.Context, req *pb.DeleteResourceRequest) (*pb.DeleteResourceResponse, error) {
+func (s *ResourceServer) ListExpiring(ctx context.Context, req *pb.ListExpiringRequest) (*pb.ListExpiringResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	before, err := parseOptionalTime("before", req.GetBefore())
+	if err != nil {
+		return nil, err
+	}
+	if before == nil {
+		now := time.Now().UTC()
+		before = &now
+	}
+
+	resources, err := s.resourceService.ListExpiring(ctx, userID, *before)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list expiring resources: %v", err)
+	}
+
+	pbResources := make([]*pb.GetResourceResponse, len(resources))
+	for i, r := range resources {
+		pbResources[i] = withExpiry(&pb.GetResourceResponse{
+			Id:            proto.Int64(r.ID),
+			Name:          proto.String(r.Name),
+			EncryptedName: r.EncryptedName,
+			Type:          proto.String(string(r.Type)),
+			Size:          proto.Int64(r.Size),
+			Metadata:      r.Metadata,
+			CreatedAt:     proto.String(r.CreatedAt.Format("2006-01-02T15:04:05Z")),
+			UpdatedAt:     proto.String(r.UpdatedAt.Format("2006-01-02T15:04:05Z")),
+			Revision:      proto.Int64(r.Revision),
+		}, r)
+	}
+
+	return &pb.ListExpiringResponse{
+		Resources: pbResources,
+	}, nil
+}
+
+func (s *ResourceServer) RestoreResource(ctx context.Context, req *pb.RestoreResourceRequest) (*pb.RestoreResourceResponse, error) {
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	err = s.resourceService.Delete(ctx, userID, req.GetId())
+	resource, err := s.resourceService.Restore(ctx, userID, req.GetId())
 	if err != nil {
 		if errors.Is(err, service.ErrAccessDenied) {
 			return nil, status.Error(codes.PermissionDenied, "access denied")
 		}
-		return nil, status.Errorf(codes.Internal, "failed to delete resource: %v", err)
+		if errors.Is(err, storage.ErrResourceNotFound) {
+			return nil, status.Error(codes.NotFound, "resource not found in trash")
+		}
+		if errors.Is(err, service.ErrResourceExists) {
+			return nil, status.Error(codes.AlreadyExists, "resource with this name already exists, rename it first")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to restore resource: %v", err)
 	}
 
-	return &pb.DeleteResourceResponse{
+	return &pb.RestoreResourceResponse{
+		Id:       proto.Int64(resource.ID),
+		Name:     proto.String(resource.Name),
+		Revision: proto.Int64(resource.Revision),
+	}, nil
+}
+
+func (s *ResourceServer) PurgeResource(ctx context.Context, req *pb.PurgeResourceRequest) (*pb.PurgeResourceResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	err = s.resourceService.Purge(ctx, userID, req.GetId())
+	if err != nil {
+		if errors.Is(err, service.ErrAccessDenied) {
+			return nil, status.Error(codes.PermissionDenied, "access denied")
+		}
+		if errors.Is(err, storage.ErrResourceNotFound) {
+			return nil, status.Error(codes.NotFound, "resource not found in trash")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to purge resource: %v", err)
+	}
+
+	return &pb.PurgeResourceResponse{
+		Success: proto.Bool(true),
+	}, nil
+}
+
+func (s *ResourceServer) AddAttachment(ctx context.Context, req *pb.AddAttachmentRequest) (*pb.AddAttachmentResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if len(req.GetEncryptedName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "attachment name is required")
+	}
+
+	attachment, err := s.resourceService.AddAttachment(ctx, userID, req.GetResourceId(), req.GetEncryptedName(), req.GetMetadata(), req.GetData())
+	if err != nil {
+		return nil, attachmentError(err, "failed to add attachment")
+	}
+
+	return &pb.AddAttachmentResponse{
+		Id: proto.Int64(attachment.ID),
+	}, nil
+}
+
+func (s *ResourceServer) ListAttachments(ctx context.Context, req *pb.ListAttachmentsRequest) (*pb.ListAttachmentsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	attachments, err := s.resourceService.ListAttachments(ctx, userID, req.GetResourceId())
+	if err != nil {
+		return nil, attachmentError(err, "failed to list attachments")
+	}
+
+	pbAttachments := make([]*pb.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		pbAttachments = append(pbAttachments, toPbAttachment(a, nil))
+	}
+
+	return &pb.ListAttachmentsResponse{
+		Attachments: pbAttachments,
+	}, nil
+}
+
+func (s *ResourceServer) GetAttachment(ctx context.Context, req *pb.GetAttachmentRequest) (*pb.Attachment, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	attachment, data, err := s.resourceService.GetAttachment(ctx, userID, req.GetId())
+	if err != nil {
+		return nil, attachmentError(err, "failed to get attachment")
+	}
+
+	return toPbAttachment(attachment, data), nil
+}
+
+func (s *ResourceServer) DeleteAttachment(ctx context.Context, req *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := s.resourceService.DeleteAttachment(ctx, userID, req.GetId()); err != nil {
+		return nil, attachmentError(err, "failed to delete attachment")
+	}
+
+	return &pb.DeleteAttachmentResponse{
+		Success: proto.Bool(true),
+	}, nil
+}
+
+func toPbAttachment(attachment *models.Attachment, data []byte) *pb.Attachment {
+	return &pb.Attachment{
+		Id:            proto.Int64(attachment.ID),
+		ResourceId:    proto.Int64(attachment.ResourceID),
+		EncryptedName: attachment.EncryptedName,
+		Metadata:      attachment.Metadata,
+		Data:          data,
+		Size:          proto.Int64(attachment.Size),
+		CreatedAt:     proto.String(attachment.CreatedAt.Format("2006-01-02T15:04:05Z")),
+	}
+}
+
+func (s *ResourceServer) ListResourceTypes(ctx context.Context, req *pb.ListResourceTypesRequest) (*pb.ListResourceTypesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	definitions, err := s.resourceService.ListTypes(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list resource types: %v", err)
+	}
+
+	pbTypes := make([]*pb.ResourceTypeDefinition, 0, len(definitions))
+	for _, d := range definitions {
+		pbTypes = append(pbTypes, toPbTypeDefinition(d))
+	}
+
+	return &pb.ListResourceTypesResponse{
+		Types: pbTypes,
+	}, nil
+}
+
+func (s *ResourceServer) DefineResourceType(ctx context.Context, req *pb.DefineResourceTypeRequest) (*pb.DefineResourceTypeResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if req.GetDefinition() == nil {
+		return nil, status.Error(codes.InvalidArgument, "type definition is required")
+	}
+
+	err = s.resourceService.DefineType(ctx, userID, fromPbTypeDefinition(req.GetDefinition()))
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidTypeDefinition) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, service.ErrTypeExists) {
+			return nil, status.Error(codes.AlreadyExists, "resource type with this name already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to define resource type: %v", err)
+	}
+
+	return &pb.DefineResourceTypeResponse{
+		Success: proto.Bool(true),
+	}, nil
+}
+
+func (s *ResourceServer) DeleteResourceType(ctx context.Context, req *pb.DeleteResourceTypeRequest) (*pb.DeleteResourceTypeResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	err = s.resourceService.DeleteType(ctx, userID, req.GetName())
+	if err != nil {
+		if errors.Is(err, service.ErrTypeInUse) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, service.ErrTypeNotFound) {
+			return nil, status.Error(codes.NotFound, "resource type not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete resource type: %v", err)
+	}
+
+	return &pb.DeleteResourceTypeResponse{
 		Success: proto.Bool(true),
 	}, nil
 }
 
+func toPbTypeDefinition(definition *models.TypeDefinition) *pb.ResourceTypeDefinition {
+	fields := make([]*pb.FieldDefinition, 0, len(definition.Fields))
+	for _, f := range definition.Fields {
+		fields = append(fields, &pb.FieldDefinition{
+			Name:        proto.String(f.Name),
+			Label:       proto.String(f.Label),
+			Kind:        proto.String(string(f.Kind)),
+			Secret:      proto.Bool(f.Secret),
+			Required:    proto.Bool(f.Required),
+			Description: proto.String(f.Description),
+		})
+	}
+
+	return &pb.ResourceTypeDefinition{
+		Name:        proto.String(definition.Name),
+		Description: proto.String(definition.Description),
+		Fields:      fields,
+		BuiltIn:     proto.Bool(definition.BuiltIn),
+	}
+}
+
+func fromPbTypeDefinition(definition *pb.ResourceTypeDefinition) *models.TypeDefinition {
+	result := &models.TypeDefinition{
+		Name:        definition.GetName(),
+		Description: definition.GetDescription(),
+	}
+	for _, f := range definition.GetFields() {
+		result.Fields = append(result.Fields, models.FieldDefinition{
+			Name:        f.GetName(),
+			Label:       f.GetLabel(),
+			Kind:        models.FieldKind(f.GetKind()),
+			Secret:      f.GetSecret(),
+			Required:    f.GetRequired(),
+			Description: f.GetDescription(),
+		})
+	}
+	return result
+}
+
+// withExpiry sets the expiry and rotation fields of a resource response
+func withExpiry(response *pb.GetResourceResponse, resource *models.Resource) *pb.GetResourceResponse {
+	if resource.ExpiresAt != nil {
+		response.ExpiresAt = proto.String(resource.ExpiresAt.Format("2006-01-02T15:04:05Z"))
+	}
+	response.RotateEvery = proto.Int64(resource.RotateEvery)
+	if !resource.RotatedAt.IsZero() {
+		response.RotatedAt = proto.String(resource.RotatedAt.Format("2006-01-02T15:04:05Z"))
+	}
+	if due := resource.DueAt(); due != nil {
+		response.DueAt = proto.String(due.Format("2006-01-02T15:04:05Z"))
+	}
+	return response
+}
+
+// parseOptionalTime parses an RFC 3339 time of a request field, nil if the value is empty
+func parseOptionalTime(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s: expected RFC 3339 time", name)
+	}
+	t = t.UTC()
+	return &t, nil
+}
+
 func getUserIDFromContext(ctx context.Context) (int64, error) {
 	userID, ok := ctx.Value(UserIDKey).(int64)
 	if !ok {
@@ -159,11 +682,52 @@ func getUserIDFromContext(ctx context.Context) (int64, error) {
 	return userID, nil
 }
 
-func isValidResourceType(t models.ResourceType) bool {
-	switch t {
-	case models.TypeCredentials, models.TypeText, models.TypeBinary, models.TypeCard:
-		return true
+// revisionAwareError converts errors of revision-checked operations to gRPC status errors
+func revisionAwareError(err error, msg string) error {
+	switch {
+	case errors.Is(err, service.ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, "access denied")
+	case errors.Is(err, storage.ErrResourceNotFound):
+		return status.Error(codes.NotFound, "resource not found")
+	case errors.Is(err, service.ErrRevisionMismatch):
+		return status.Error(codes.FailedPrecondition, "resource has been modified: expected revision is not current")
+	case errors.Is(err, service.ErrConcurrentUpdate):
+		return status.Error(codes.Aborted, "resource was modified concurrently")
+	case errors.Is(err, models.ErrInvalidResourceName):
+		return status.Error(codes.InvalidArgument, "invalid resource name")
+	case errors.Is(err, service.ErrResourceExists):
+		return status.Error(codes.AlreadyExists, "resource with this name already exists")
+	case errors.Is(err, service.ErrMetadataTooLarge):
+		return status.Error(codes.InvalidArgument, "resource metadata is too large")
 	default:
-		return false
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
+
+// attachmentError maps service errors of attachment operations to gRPC status errors
+func attachmentError(err error, msg string) error {
+	switch {
+	case errors.Is(err, service.ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, "access denied")
+	case errors.Is(err, service.ErrAttachmentNotFound):
+		return status.Error(codes.NotFound, "attachment not found")
+	case errors.Is(err, service.ErrResourceNotFound):
+		return status.Error(codes.NotFound, "resource not found")
+	case errors.Is(err, service.ErrMetadataTooLarge):
+		return status.Error(codes.InvalidArgument, "attachment metadata is too large")
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
+
+// checkResourceType returns an InvalidArgument error if the type is neither built-in nor defined by the user
+func (s *ResourceServer) checkResourceType(ctx context.Context, userID int64, t models.ResourceType) error {
+	valid, err := s.resourceService.IsValidType(ctx, userID, t)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check resource type: %v", err)
+	}
+	if !valid {
+		return status.Error(codes.InvalidArgument, "invalid resource type")
 	}
+	return nil
 }
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/logger"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+)
+
+// AddAttachment attaches a file to a resource of the user
+// The data is stored like resource data: in PostgreSQL if small, in MinIO otherwise.
+// encryptedName, metadata and data are encrypted on the client and stored as is.
+func (s *ResourceService) AddAttachment(ctx context.Context, userID, resourceID int64, encryptedName, metadata, data []byte) (*models.Attachment, error) {
+	if len(metadata) > maxMetadataSize {
+		return nil, ErrMetadataTooLarge
+	}
+
+	resource, err := s.resourceRepo.GetByID(ctx, resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource: %w", err)
+	}
+	if resource.UserID != userID {
+		return nil, ErrAccessDenied
+	}
+
+	attachment := &models.Attachment{
+		ResourceID:    resourceID,
+		UserID:        userID,
+		EncryptedName: encryptedName,
+		Size:          int64(len(data)),
+		Metadata:      metadata,
+	}
+
+	attachment.Storage, attachment.ObjectKey, attachment.Data, err = s.storeData(ctx, userID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.resourceRepo.CreateAttachment(ctx, attachment)
+	if err != nil {
+		// Rollback: if the database write failed, delete from MinIO
+		if cleanupErr := s.deleteObject(ctx, attachment.Storage, attachment.ObjectKey); cleanupErr != nil {
+			logger.Sugar.Error("failed to delete from file storage", "error", cleanupErr)
+		}
+		return nil, fmt.Errorf("failed to save attachment: %w", err)
+	}
+
+	return created, nil
+}
+
+// ListAttachments returns attachments of a resource of the user without their data
+func (s *ResourceService) ListAttachments(ctx context.Context, userID, resourceID int64) ([]*models.Attachment, error) {
+	resource, err := s.resourceRepo.GetByID(ctx, resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource: %w", err)
+	}
+	if resource.UserID != userID {
+		return nil, ErrAccessDenied
+	}
+
+	return s.resourceRepo.ListAttachments(ctx, resourceID)
+}
+
+// GetAttachment returns an attachment of the user together with its data
+func (s *ResourceService) GetAttachment(ctx context.Context, userID, attachmentID int64) (*models.Attachment, []byte, error) {
+	attachment, err := s.getAttachment(ctx, userID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := s.loadData(ctx, attachment.Storage, attachment.ObjectKey, attachment.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, data, nil
+}
+
+// DeleteAttachment permanently deletes an attachment of the user together with its data in file storage
+func (s *ResourceService) DeleteAttachment(ctx context.Context, userID, attachmentID int64) error {
+	attachment, err := s.getAttachment(ctx, userID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.deleteObject(ctx, attachment.Storage, attachment.ObjectKey); err != nil {
+		return err
+	}
+
+	if err := s.resourceRepo.DeleteAttachment(ctx, attachment.ID); err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	return nil
+}
+
+// getAttachment returns an attachment of a resource of the user that is not in trash
+func (s *ResourceService) getAttachment(ctx context.Context, userID, attachmentID int64) (*models.Attachment, error) {
+	attachment, err := s.resourceRepo.GetAttachmentByID(ctx, attachmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+	if attachment.UserID != userID {
+		return nil, ErrAccessDenied
+	}
+
+	if _, err := s.resourceRepo.GetByID(ctx, attachment.ResourceID); err != nil {
+		return nil, fmt.Errorf("failed to get resource: %w", err)
+	}
+
+	return attachment, nil
+}
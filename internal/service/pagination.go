@@ -0,0 +1,82 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/repository"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrInvalidSortField = errors.New("invalid sort field")
+)
+
+// pageToken is an opaque continuation token returned to clients
+// It is bound to the sort order it was issued for
+type pageToken struct {
+	SortBy     repository.SortField `json:"s"`
+	Descending bool                 `json:"d"`
+	Value      string               `json:"v"`
+	ID         int64                `json:"i"`
+}
+
+func encodePageToken(resource *models.Resource, sortBy repository.SortField, descending bool) string {
+	token := pageToken{SortBy: sortBy, Descending: descending, ID: resource.ID}
+
+	switch value := repository.CursorFor(resource, sortBy).Value.(type) {
+	case string:
+		token.Value = value
+	case time.Time:
+		token.Value = value.Format(time.RFC3339Nano)
+	case int64:
+		token.Value = strconv.FormatInt(value, 10)
+	}
+
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(encoded string, sortBy repository.SortField, descending bool) (*repository.Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if token.SortBy != sortBy || token.Descending != descending {
+		return nil, ErrInvalidPageToken
+	}
+
+	cursor := &repository.Cursor{ID: token.ID}
+	switch sortBy {
+	case repository.SortByName:
+		cursor.Value = token.Value
+	case repository.SortBySize:
+		size, err := strconv.ParseInt(token.Value, 10, 64)
+		if err != nil {
+			return nil, ErrInvalidPageToken
+		}
+		cursor.Value = size
+	default:
+		t, err := time.Parse(time.RFC3339Nano, token.Value)
+		if err != nil {
+			return nil, ErrInvalidPageToken
+		}
+		cursor.Value = t
+	}
+
+	return cursor, nil
+}
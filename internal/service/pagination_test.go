@@ -0,0 +1,85 @@
+package service
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/repository"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	resource := &models.Resource{
+		ID:        42,
+		Name:      "work/github",
+		Size:      1234,
+		CreatedAt: time.Date(2025, 3, 1, 10, 20, 30, 123456789, time.UTC),
+		UpdatedAt: time.Date(2025, 4, 2, 11, 0, 0, 0, time.FixedZone("MSK", 3*60*60)),
+	}
+
+	tests := []struct {
+		sortBy     repository.SortField
+		descending bool
+		want       any
+	}{
+		{sortBy: repository.SortByName, want: resource.Name},
+		{sortBy: repository.SortBySize, descending: true, want: resource.Size},
+		{sortBy: repository.SortByCreatedAt, want: resource.CreatedAt},
+		{sortBy: repository.SortByUpdatedAt, descending: true, want: resource.UpdatedAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sortBy), func(t *testing.T) {
+			token := encodePageToken(resource, tt.sortBy, tt.descending)
+			cursor, err := decodePageToken(token, tt.sortBy, tt.descending)
+			if err != nil {
+				t.Fatalf("decodePageToken() error = %v", err)
+			}
+			if cursor.ID != resource.ID {
+				t.Errorf("cursor ID = %d, want %d", cursor.ID, resource.ID)
+			}
+
+			// Times are compared as instants, the location is not kept
+			if want, ok := tt.want.(time.Time); ok {
+				got, ok := cursor.Value.(time.Time)
+				if !ok || !got.Equal(want) {
+					t.Errorf("cursor value = %v, want %v", cursor.Value, want)
+				}
+				return
+			}
+			if cursor.Value != tt.want {
+				t.Errorf("cursor value = %#v, want %#v", cursor.Value, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodePageTokenInvalid(t *testing.T) {
+	resource := &models.Resource{ID: 1, Name: "a", CreatedAt: time.Now()}
+	byName := encodePageToken(resource, repository.SortByName, false)
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+
+	tests := []struct {
+		name       string
+		token      string
+		sortBy     repository.SortField
+		descending bool
+	}{
+		{name: "not base64", token: "!!!", sortBy: repository.SortByName},
+		{name: "not json", token: encode("not json"), sortBy: repository.SortByName},
+		{name: "other sort field", token: byName, sortBy: repository.SortByCreatedAt},
+		{name: "other direction", token: byName, sortBy: repository.SortByName, descending: true},
+		{name: "invalid size", token: encode(`{"s":"size","v":"big","i":1}`), sortBy: repository.SortBySize},
+		{name: "invalid time", token: encode(`{"s":"created_at","v":"yesterday","i":1}`), sortBy: repository.SortByCreatedAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodePageToken(tt.token, tt.sortBy, tt.descending); !errors.Is(err, ErrInvalidPageToken) {
+				t.Errorf("decodePageToken() error = %v, want %v", err, ErrInvalidPageToken)
+			}
+		})
+	}
+}
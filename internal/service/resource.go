@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/OvsienkoValeriya/GophKeeper/internal/logger"
 	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
@@ -17,10 +18,40 @@ import (
 
 var (
 	ErrAccessDenied     = errors.New("access denied")
-	ErrResourceNotFound = errors.New("resource not found")
+	ErrResourceNotFound = storage.ErrResourceNotFound
+	ErrResourceExists   = storage.ErrResourceExists
+	// ErrAttachmentNotFound is returned when the attachment does not exist
+	ErrAttachmentNotFound = storage.ErrAttachmentNotFound
+	// ErrRevisionMismatch is returned when the expected revision is not the current one
+	ErrRevisionMismatch = errors.New("resource revision mismatch")
+	// ErrConcurrentUpdate is returned when the resource was changed while the update was in progress
+	ErrConcurrentUpdate = errors.New("resource was modified concurrently")
+	// ErrMetadataTooLarge is returned when the encrypted metadata exceeds maxMetadataSize
+	ErrMetadataTooLarge = errors.New("resource metadata is too large")
+	// ErrInvalidUpdateMask is returned when a partial update lists no or unknown fields
+	ErrInvalidUpdateMask = errors.New("invalid update mask")
+	// ErrInvalidRotation is returned when the rotation interval is negative
+	ErrInvalidRotation = errors.New("invalid rotation interval")
 )
 
-const maxPostgresSize = 1 << 20 // 1 МБ
+// Fields of a resource that can be changed with UpdateFields
+const (
+	FieldName        = "name"
+	FieldType        = "type"
+	FieldMetadata    = "metadata"
+	FieldData        = "data"
+	FieldExpiresAt   = "expires_at"
+	FieldRotateEvery = "rotate_every"
+	// FieldRotated is not a field: listed with FieldData it marks the new data as a new
+	// secret value, e.g. a changed password, which restarts the rotation interval
+	FieldRotated = "rotated"
+)
+
+const (
+	maxPostgresSize = 1 << 20  // 1 МБ
+	maxMetadataSize = 64 << 10 // 64 KB
+	purgeBatchSize  = 100      // resources loaded at once by PurgeExpired
+)
 
 type ResourceService struct {
 	resourceRepo repository.ResourceRepository
@@ -37,26 +68,40 @@ func NewResourceService(resourceRepo repository.ResourceRepository, fileStorage
 // Upload uploads a resource:
 // - small data (< 1 MB) is saved in PostgreSQL
 // - large data (>= 1 MB) is saved in MinIO, metadata is saved in PostgreSQL
-func (s *ResourceService) Upload(ctx context.Context, userID int64, name string,
-	resourceType models.ResourceType, data []byte) (*models.Resource, error) {
+//
+// encryptedName and metadata are encrypted on the client and stored as is, they may be nil.
+// If encryptedName is set, name is its blind index.
+// expiresAt (may be nil) and rotateEvery (seconds, 0 for none) define when the resource is due for rotation
+func (s *ResourceService) Upload(ctx context.Context, userID int64, name string, encryptedName []byte,
+	resourceType models.ResourceType, data, metadata []byte, expiresAt *time.Time, rotateEvery int64) (*models.Resource, error) {
+
+	name, err := models.CleanResourceName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(metadata) > maxMetadataSize {
+		return nil, ErrMetadataTooLarge
+	}
+
+	if rotateEvery < 0 {
+		return nil, ErrInvalidRotation
+	}
 
 	resource := &models.Resource{
-		UserID: userID,
-		Name:   name,
-		Type:   resourceType,
-		Size:   int64(len(data)),
+		UserID:        userID,
+		Name:          name,
+		EncryptedName: encryptedName,
+		Type:          resourceType,
+		Size:          int64(len(data)),
+		Metadata:      metadata,
+		ExpiresAt:     expiresAt,
+		RotateEvery:   rotateEvery,
 	}
 
-	if len(data) < maxPostgresSize {
-		resource.Storage = models.StoragePostgres
-		resource.Data = data
-	} else {
-		resource.Storage = models.StorageMinio
-		resource.ObjectKey = generateObjectKey(userID)
-
-		if err := s.fileStorage.Upload(ctx, resource.ObjectKey, bytes.NewReader(data), resource.Size, minio.PutObjectOptions{}); err != nil {
-			return nil, fmt.Errorf("failed to upload to file storage: %w", err)
-		}
+	resource.Storage, resource.ObjectKey, resource.Data, err = s.storeData(ctx, userID, data)
+	if err != nil {
+		return nil, err
 	}
 
 	created, err := s.resourceRepo.Create(ctx, resource)
@@ -82,63 +127,119 @@ func (s *ResourceService) Get(ctx context.Context, userID, resourceID int64) (*m
 		return nil, nil, ErrAccessDenied
 	}
 
-	var data []byte
-
-	if resource.Storage == models.StoragePostgres {
-		data = resource.Data
-	} else {
-		reader, err := s.fileStorage.Download(ctx, resource.ObjectKey, minio.GetObjectOptions{})
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to download from file storage: %w", err)
-		}
-		defer reader.Close()
-
-		data, err = io.ReadAll(reader)
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to read data: %w", err)
-		}
+	data, err := s.loadData(ctx, resource.Storage, resource.ObjectKey, resource.Data)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	return resource, data, nil
 }
 
-func (s *ResourceService) GetByName(ctx context.Context, userID int64, name string) (*models.Resource, []byte, error) {
+// GetByName returns a resource of the user by name, its data is loaded only if withData is set,
+// so checking that a name is taken never downloads a large file
+func (s *ResourceService) GetByName(ctx context.Context, userID int64, name string, withData bool) (*models.Resource, []byte, error) {
 	resource, err := s.resourceRepo.GetByNameAndUserID(ctx, userID, name)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get resource: %w", err)
 	}
+	if !withData {
+		return resource, nil, nil
+	}
 
-	var data []byte
+	data, err := s.loadData(ctx, resource.Storage, resource.ObjectKey, resource.Data)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if resource.Storage == models.StoragePostgres {
-		data = resource.Data
-	} else {
-		reader, err := s.fileStorage.Download(ctx, resource.ObjectKey, minio.GetObjectOptions{})
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to download from file storage: %w", err)
-		}
-		defer reader.Close()
+	return resource, data, nil
+}
+
+// List returns a page of resources of the user matching opts, without their data
+// Parameters:
+//   - opts: filters and sort order, Limit and After are set from pageSize and pageToken
+//   - pageSize: maximum number of resources in the page, default if 0
+//   - pageToken: token returned with the previous page, empty for the first page
+//
+// Returns:
+//   - resources of the page
+//   - token of the next page, empty if this page is the last one
+func (s *ResourceService) List(ctx context.Context, userID int64, opts repository.ListOptions,
+	pageSize int, pageToken string) ([]*models.Resource, string, error) {
+
+	pathPrefix, err := models.CleanPathPrefix(opts.PathPrefix)
+	if err != nil {
+		return nil, "", err
+	}
+	opts.PathPrefix = pathPrefix
+
+	if opts.SortBy == "" {
+		opts.SortBy = repository.SortByCreatedAt
+		opts.Descending = true
+	}
+	if !opts.SortBy.IsValid() {
+		return nil, "", ErrInvalidSortField
+	}
 
-		data, err = io.ReadAll(reader)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	if pageToken != "" {
+		opts.After, err = decodePageToken(pageToken, opts.SortBy, opts.Descending)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to read data: %w", err)
+			return nil, "", err
 		}
 	}
 
-	return resource, data, nil
+	// Fetch one extra resource to find out if there is a next page
+	opts.Limit = pageSize + 1
+	resources, err := s.resourceRepo.List(ctx, userID, opts)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get resources: %w", err)
+	}
+
+	nextPageToken := ""
+	if len(resources) > pageSize {
+		resources = resources[:pageSize]
+		nextPageToken = encodePageToken(resources[pageSize-1], opts.SortBy, opts.Descending)
+	}
+
+	return resources, nextPageToken, nil
 }
 
-func (s *ResourceService) GetAll(ctx context.Context, userID int64) ([]*models.Resource, error) {
-	resources, err := s.resourceRepo.GetByUserID(ctx, userID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get resources: %w", err)
+// Update replaces the data of a resource if its current revision equals expectedRevision
+// If metadata is nil the current metadata is kept. If encryptedName is set, name is its blind index,
+// a nil encryptedName keeps the current encrypted name while the name does not change.
+// The rotation interval is not restarted, use UpdateFields with FieldRotated for that
+func (s *ResourceService) Update(ctx context.Context, userID, resourceID, expectedRevision int64, name string, encryptedName []byte,
+	resourceType models.ResourceType, data, metadata []byte) (*models.Resource, error) {
+
+	fields := []string{FieldName, FieldType, FieldData}
+	if metadata != nil {
+		fields = append(fields, FieldMetadata)
 	}
 
-	return resources, nil
+	changes := &models.Resource{Name: name, EncryptedName: encryptedName, Type: resourceType, Data: data, Metadata: metadata}
+	return s.UpdateFields(ctx, userID, resourceID, expectedRevision, changes, fields)
 }
 
-func (s *ResourceService) Update(ctx context.Context, userID, resourceID int64, name string,
-	resourceType models.ResourceType, data []byte) (*models.Resource, error) {
+// UpdateFields applies the listed fields of changes to a resource if its current revision equals expectedRevision
+// Parameters:
+//   - changes: new values, only the listed fields are read. Data holds the new data
+//   - fields: FieldName (with EncryptedName), FieldType, FieldMetadata, FieldData, FieldExpiresAt, FieldRotateEvery,
+//     FieldRotated (only with FieldData)
+//
+// Stored data is uploaded again only if FieldData is listed, so renaming or
+// tagging a large file never rewrites it in file storage. Only data listed with FieldRotated rotates the resource
+func (s *ResourceService) UpdateFields(ctx context.Context, userID, resourceID, expectedRevision int64,
+	changes *models.Resource, fields []string) (*models.Resource, error) {
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: no fields", ErrInvalidUpdateMask)
+	}
 
 	existing, err := s.resourceRepo.GetByID(ctx, resourceID)
 	if err != nil {
@@ -149,58 +250,152 @@ func (s *ResourceService) Update(ctx context.Context, userID, resourceID int64,
 		return nil, ErrAccessDenied
 	}
 
-	newSize := int64(len(data))
-	newStorage := models.StoragePostgres
-	if len(data) >= maxPostgresSize {
-		newStorage = models.StorageMinio
+	if existing.Revision != expectedRevision {
+		return nil, ErrRevisionMismatch
 	}
 
-	oldStorage := existing.Storage
-	oldObjectKey := existing.ObjectKey
-
-	resource := &models.Resource{
-		ID:     resourceID,
-		UserID: userID,
-		Name:   name,
-		Type:   resourceType,
-		Size:   newSize,
-	}
-
-	if newStorage == models.StoragePostgres {
-		resource.Storage = models.StoragePostgres
-		resource.Data = data
-		resource.ObjectKey = ""
-	} else {
-		resource.Storage = models.StorageMinio
-
-		if oldStorage == models.StorageMinio && oldObjectKey != "" {
-			resource.ObjectKey = oldObjectKey
-		} else {
-			resource.ObjectKey = generateObjectKey(userID)
+	resource := *existing
+	updateData := false
+	rotated := false
+	for _, field := range fields {
+		switch field {
+		case FieldName:
+			name, err := models.CleanResourceName(changes.Name)
+			if err != nil {
+				return nil, err
+			}
+			// The same name without a new encrypted name keeps the current one
+			if name != existing.Name || changes.EncryptedName != nil {
+				resource.EncryptedName = changes.EncryptedName
+			}
+			resource.Name = name
+		case FieldType:
+			resource.Type = changes.Type
+		case FieldMetadata:
+			if len(changes.Metadata) > maxMetadataSize {
+				return nil, ErrMetadataTooLarge
+			}
+			resource.Metadata = changes.Metadata
+		case FieldData:
+			updateData = true
+		case FieldExpiresAt:
+			resource.ExpiresAt = changes.ExpiresAt
+		case FieldRotateEvery:
+			if changes.RotateEvery < 0 {
+				return nil, ErrInvalidRotation
+			}
+			resource.RotateEvery = changes.RotateEvery
+		case FieldRotated:
+			rotated = true
+		default:
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidUpdateMask, field)
 		}
+	}
 
-		if err := s.fileStorage.Upload(ctx, resource.ObjectKey, bytes.NewReader(data), newSize, minio.PutObjectOptions{}); err != nil {
-			return nil, fmt.Errorf("failed to upload to file storage: %w", err)
+	if rotated && !updateData {
+		return nil, fmt.Errorf("%w: %s requires %s", ErrInvalidUpdateMask, FieldRotated, FieldData)
+	}
+
+	if !updateData {
+		resource.Data = nil
+		if err := s.resourceRepo.UpdateAttributes(ctx, &resource); err != nil {
+			if errors.Is(err, storage.ErrRevisionMismatch) {
+				return nil, ErrConcurrentUpdate
+			}
+			return nil, fmt.Errorf("failed to update resource: %w", err)
 		}
+		return &resource, nil
+	}
+
+	resource.Size = int64(len(changes.Data))
+	resource.Storage, resource.ObjectKey, resource.Data, err = s.storeData(ctx, userID, changes.Data)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.resourceRepo.Update(ctx, resource); err != nil {
-		// Rollback: if the database write failed and we uploaded a new file
-		if newStorage == models.StorageMinio && oldStorage != models.StorageMinio {
+	if err := s.resourceRepo.Update(ctx, &resource, rotated); err != nil {
+		// Rollback: if the database write failed, delete the newly uploaded file
+		if resource.Storage == models.StorageMinio {
 			_ = s.fileStorage.Delete(ctx, resource.ObjectKey, minio.RemoveObjectOptions{})
 		}
+		if errors.Is(err, storage.ErrRevisionMismatch) {
+			return nil, ErrConcurrentUpdate
+		}
 		return nil, fmt.Errorf("failed to update resource: %w", err)
 	}
 
-	// If it was in MinIO before and now in PostgreSQL, delete the old file
-	if oldStorage == models.StorageMinio && newStorage == models.StoragePostgres && oldObjectKey != "" {
-		_ = s.fileStorage.Delete(ctx, oldObjectKey, minio.RemoveObjectOptions{})
+	// The previous revision's file is no longer referenced
+	if existing.Storage == models.StorageMinio && existing.ObjectKey != "" {
+		_ = s.fileStorage.Delete(ctx, existing.ObjectKey, minio.RemoveObjectOptions{})
+	}
+
+	return &resource, nil
+}
+
+// Rename changes the name of a resource if its current revision equals expectedRevision
+// The resource data is not touched. If encryptedName is set, newName is its blind index,
+// otherwise the resource gets a plaintext name
+func (s *ResourceService) Rename(ctx context.Context, userID, resourceID, expectedRevision int64, newName string, encryptedName []byte) (*models.Resource, error) {
+	newName, err := models.CleanResourceName(newName)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := s.resourceRepo.GetByID(ctx, resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource: %w", err)
+	}
+
+	if resource.UserID != userID {
+		return nil, ErrAccessDenied
+	}
+
+	if resource.Revision != expectedRevision {
+		return nil, ErrRevisionMismatch
+	}
+
+	resource.Name = newName
+	resource.EncryptedName = encryptedName
+	if err := s.resourceRepo.Rename(ctx, resource); err != nil {
+		if errors.Is(err, storage.ErrRevisionMismatch) {
+			return nil, ErrConcurrentUpdate
+		}
+		return nil, fmt.Errorf("failed to rename resource: %w", err)
 	}
 
 	return resource, nil
 }
 
-func (s *ResourceService) Delete(ctx context.Context, userID, resourceID int64) error {
+// MoveFolder moves all resources of the user from one folder to another
+// Returns the number of moved resources
+//
+// Deprecated: only resources with plaintext names (stored before names were encrypted) are moved,
+// folders of encrypted names are known to the client only, which renames each resource with Rename
+func (s *ResourceService) MoveFolder(ctx context.Context, userID int64, from, to string) (int64, error) {
+	from, err := models.CleanResourceName(from)
+	if err != nil {
+		return 0, err
+	}
+	to, err = models.CleanPathPrefix(to)
+	if err != nil {
+		return 0, err
+	}
+
+	if from == to {
+		return 0, nil
+	}
+
+	moved, err := s.resourceRepo.MoveFolder(ctx, userID, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("failed to move folder: %w", err)
+	}
+
+	return moved, nil
+}
+
+// Delete moves a resource to trash if its current revision equals expectedRevision.
+// Its data is kept until the resource is purged
+func (s *ResourceService) Delete(ctx context.Context, userID, resourceID, expectedRevision int64) error {
 	resource, err := s.resourceRepo.GetByID(ctx, resourceID)
 	if err != nil {
 		return fmt.Errorf("failed to get resource: %w", err)
@@ -210,19 +405,180 @@ func (s *ResourceService) Delete(ctx context.Context, userID, resourceID int64)
 		return ErrAccessDenied
 	}
 
-	if resource.Storage == models.StorageMinio && resource.ObjectKey != "" {
-		if err := s.fileStorage.Delete(ctx, resource.ObjectKey, minio.RemoveObjectOptions{}); err != nil {
-			return fmt.Errorf("failed to delete from file storage: %w", err)
+	if resource.Revision != expectedRevision {
+		return ErrRevisionMismatch
+	}
+
+	if err := s.resourceRepo.SoftDelete(ctx, resourceID, expectedRevision); err != nil {
+		if errors.Is(err, storage.ErrRevisionMismatch) {
+			return ErrConcurrentUpdate
 		}
+		return fmt.Errorf("failed to delete resource: %w", err)
 	}
 
-	if err := s.resourceRepo.Delete(ctx, resourceID); err != nil {
+	return nil
+}
+
+func (s *ResourceService) ListTrash(ctx context.Context, userID int64) ([]*models.Resource, error) {
+	resources, err := s.resourceRepo.GetDeletedByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted resources: %w", err)
+	}
+
+	return resources, nil
+}
+
+// ListExpiring returns live resources of the user that expire or are due for rotation before the given time,
+// the earliest due first
+func (s *ResourceService) ListExpiring(ctx context.Context, userID int64, before time.Time) ([]*models.Resource, error) {
+	resources, err := s.resourceRepo.ListExpiring(ctx, userID, before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expiring resources: %w", err)
+	}
+
+	return resources, nil
+}
+
+func (s *ResourceService) Restore(ctx context.Context, userID, resourceID int64) (*models.Resource, error) {
+	resource, err := s.resourceRepo.GetDeletedByID(ctx, resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted resource: %w", err)
+	}
+
+	if resource.UserID != userID {
+		return nil, ErrAccessDenied
+	}
+
+	if err := s.resourceRepo.Restore(ctx, resourceID); err != nil {
+		return nil, fmt.Errorf("failed to restore resource: %w", err)
+	}
+	resource.DeletedAt = nil
+	resource.Revision++
+
+	return resource, nil
+}
+
+// Purge permanently deletes a resource from trash together with its data in file storage
+func (s *ResourceService) Purge(ctx context.Context, userID, resourceID int64) error {
+	resource, err := s.resourceRepo.GetDeletedByID(ctx, resourceID)
+	if err != nil {
+		return fmt.Errorf("failed to get deleted resource: %w", err)
+	}
+
+	if resource.UserID != userID {
+		return ErrAccessDenied
+	}
+
+	return s.purge(ctx, resource)
+}
+
+// PurgeExpired permanently deletes resources of all users that stay in trash longer than retention
+// A resource that cannot be purged is logged and skipped, so it never blocks the others
+// Returns the number of purged resources and the joined errors of the skipped ones
+func (s *ResourceService) PurgeExpired(ctx context.Context, retention time.Duration) (int, error) {
+	before := time.Now().Add(-retention)
+
+	purged := 0
+	var errs []error
+	var afterDeletedAt time.Time
+	var afterID int64
+	for {
+		resources, err := s.resourceRepo.GetDeletedBefore(ctx, before, afterDeletedAt, afterID, purgeBatchSize)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get expired resources: %w", err))
+			break
+		}
+
+		for _, resource := range resources {
+			if err := s.purge(ctx, resource); err != nil {
+				logger.Sugar.Errorf("Failed to purge resource %d: %v", resource.ID, err)
+				errs = append(errs, fmt.Errorf("resource %d: %w", resource.ID, err))
+				continue
+			}
+			purged++
+		}
+
+		if len(resources) < purgeBatchSize || ctx.Err() != nil {
+			break
+		}
+		last := resources[len(resources)-1]
+		afterDeletedAt, afterID = *last.DeletedAt, last.ID
+	}
+
+	return purged, errors.Join(errs...)
+}
+
+// purge deletes the data of the resource and its attachments from file storage, then the resource itself
+// Attachment rows are deleted by the database together with the resource
+func (s *ResourceService) purge(ctx context.Context, resource *models.Resource) error {
+	attachments, err := s.resourceRepo.ListAttachments(ctx, resource.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list attachments: %w", err)
+	}
+	for _, attachment := range attachments {
+		if err := s.deleteObject(ctx, attachment.Storage, attachment.ObjectKey); err != nil {
+			return err
+		}
+	}
+
+	if err := s.deleteObject(ctx, resource.Storage, resource.ObjectKey); err != nil {
+		return err
+	}
+
+	if err := s.resourceRepo.Delete(ctx, resource.ID); err != nil {
 		return fmt.Errorf("failed to delete resource: %w", err)
 	}
 
 	return nil
 }
 
+// storeData saves data according to its size:
+// - small data (< 1 MB) is returned to be saved in PostgreSQL
+// - large data (>= 1 MB) is uploaded to MinIO under a new object key
+//
+// Returns the storage type, the MinIO object key and the data to save in PostgreSQL
+func (s *ResourceService) storeData(ctx context.Context, userID int64, data []byte) (models.StorageType, string, []byte, error) {
+	if len(data) < maxPostgresSize {
+		return models.StoragePostgres, "", data, nil
+	}
+
+	objectKey := generateObjectKey(userID)
+	if err := s.fileStorage.Upload(ctx, objectKey, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{}); err != nil {
+		return "", "", nil, fmt.Errorf("failed to upload to file storage: %w", err)
+	}
+	return models.StorageMinio, objectKey, nil, nil
+}
+
+// loadData returns data saved by storeData
+func (s *ResourceService) loadData(ctx context.Context, storageType models.StorageType, objectKey string, pgData []byte) ([]byte, error) {
+	if storageType == models.StoragePostgres {
+		return pgData, nil
+	}
+
+	reader, err := s.fileStorage.Download(ctx, objectKey, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download from file storage: %w", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data: %w", err)
+	}
+	return data, nil
+}
+
+// deleteObject deletes data saved by storeData from MinIO, data in PostgreSQL is deleted with its row
+func (s *ResourceService) deleteObject(ctx context.Context, storageType models.StorageType, objectKey string) error {
+	if storageType != models.StorageMinio || objectKey == "" {
+		return nil
+	}
+	if err := s.fileStorage.Delete(ctx, objectKey, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("failed to delete from file storage: %w", err)
+	}
+	return nil
+}
+
 func generateObjectKey(userID int64) string {
 	return fmt.Sprintf("users/%d/%s", userID, uuid.New().String())
 }
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/models"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/repository"
+	"github.com/OvsienkoValeriya/GophKeeper/internal/repository/storage"
+)
+
+var (
+	ErrTypeNotFound = storage.ErrTypeNotFound
+	ErrTypeExists   = storage.ErrTypeExists
+	// ErrTypeInUse is returned when a type that still has resources is deleted
+	ErrTypeInUse = errors.New("resource type is in use")
+)
+
+// maxUserTypes limits the number of types a user can define
+const maxUserTypes = 100
+
+// ListTypes returns the built-in types followed by the types defined by the user
+func (s *ResourceService) ListTypes(ctx context.Context, userID int64) ([]*models.TypeDefinition, error) {
+	userTypes, err := s.resourceRepo.ListTypes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := make([]*models.TypeDefinition, 0, len(models.BuiltInTypes)+len(userTypes))
+	for i := range models.BuiltInTypes {
+		definitions = append(definitions, &models.BuiltInTypes[i])
+	}
+	return append(definitions, userTypes...), nil
+}
+
+// IsValidType checks if the type is built-in or defined by the user
+func (s *ResourceService) IsValidType(ctx context.Context, userID int64, resourceType models.ResourceType) (bool, error) {
+	if models.IsBuiltInType(resourceType) {
+		return true, nil
+	}
+
+	userTypes, err := s.resourceRepo.ListTypes(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, t := range userTypes {
+		if t.Name == string(resourceType) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// DefineType adds a user-defined resource type
+// Built-in type names cannot be redefined, a defined type cannot be changed, only deleted
+func (s *ResourceService) DefineType(ctx context.Context, userID int64, definition *models.TypeDefinition) error {
+	if err := definition.Validate(); err != nil {
+		return err
+	}
+	if models.IsBuiltInType(models.ResourceType(definition.Name)) {
+		return ErrTypeExists
+	}
+
+	userTypes, err := s.resourceRepo.ListTypes(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(userTypes) >= maxUserTypes {
+		return fmt.Errorf("%w: at most %d types can be defined", models.ErrInvalidTypeDefinition, maxUserTypes)
+	}
+
+	definition.BuiltIn = false
+	return s.resourceRepo.CreateType(ctx, userID, definition)
+}
+
+// DeleteType deletes a user-defined resource type that has no resources, including ones in trash
+func (s *ResourceService) DeleteType(ctx context.Context, userID int64, name string) error {
+	if models.IsBuiltInType(models.ResourceType(name)) {
+		return fmt.Errorf("%w: built-in types cannot be deleted", ErrTypeInUse)
+	}
+
+	resources, err := s.resourceRepo.List(ctx, userID, repository.ListOptions{Type: models.ResourceType(name), Limit: 1})
+	if err != nil {
+		return fmt.Errorf("failed to list resources: %w", err)
+	}
+	if len(resources) > 0 {
+		return ErrTypeInUse
+	}
+
+	deleted, err := s.resourceRepo.GetDeletedByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to list trash: %w", err)
+	}
+	for _, r := range deleted {
+		if r.Type == models.ResourceType(name) {
+			return ErrTypeInUse
+		}
+	}
+
+	return s.resourceRepo.DeleteType(ctx, userID, name)
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/OvsienkoValeriya/GophKeeper/internal/logger"
+)
+
+// TrashPurger periodically purges resources that stay in trash longer than the retention window
+type TrashPurger struct {
+	resourceService *ResourceService
+	retention       time.Duration
+	interval        time.Duration
+}
+
+// NewTrashPurger creates a purger, interval must be positive and retention must not be negative
+func NewTrashPurger(resourceService *ResourceService, retention, interval time.Duration) *TrashPurger {
+	return &TrashPurger{
+		resourceService: resourceService,
+		retention:       retention,
+		interval:        interval,
+	}
+}
+
+// Run purges expired resources every interval until ctx is done
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *TrashPurger) purge(ctx context.Context) {
+	purged, err := p.resourceService.PurgeExpired(ctx, p.retention)
+	if err != nil {
+		logger.Sugar.Errorf("Failed to purge trash: %v", err)
+	}
+	if purged > 0 {
+		logger.Sugar.Infof("Purged %d resources from trash", purged)
+	}
+}
@@ -0,0 +1,3 @@
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP DEFAULT NULL; -- set when the resource is moved to trash
+
+CREATE INDEX IF NOT EXISTS idx_resources_deleted_at ON resources(deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS revision BIGINT NOT NULL DEFAULT 1; -- incremented on every change, used for optimistic locking
+
+UPDATE resources SET updated_at = created_at WHERE updated_at IS NULL;
+ALTER TABLE resources ALTER COLUMN updated_at SET DEFAULT NOW();
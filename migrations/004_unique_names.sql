@@ -0,0 +1,11 @@
+-- Rename existing duplicates so that names become unique per user
+UPDATE resources r
+SET name = LEFT(r.name, 240) || ' (' || r.id || ')'
+WHERE r.deleted_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM resources o
+    WHERE o.user_id = r.user_id AND o.name = r.name AND o.deleted_at IS NULL AND o.id < r.id
+  );
+
+-- Names are unique among live resources; trashed resources may share a name with a live one
+CREATE UNIQUE INDEX IF NOT EXISTS idx_resources_user_id_name ON resources(user_id, name) WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+-- Keyset pagination indexes for listing live resources
+CREATE INDEX IF NOT EXISTS idx_resources_user_id_created_at ON resources(user_id, created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_resources_user_id_updated_at ON resources(user_id, updated_at, id) WHERE deleted_at IS NULL;
@@ -0,0 +1,10 @@
+-- Metadata is encrypted on the client, so it is stored as opaque bytes rather than JSON
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'resources' AND column_name = 'metadata' AND data_type = 'jsonb'
+    ) THEN
+        ALTER TABLE resources ALTER COLUMN metadata TYPE BYTEA USING convert_to(metadata::text, 'UTF8');
+    END IF;
+END $$;
@@ -0,0 +1,2 @@
+-- Names encrypted on the client, the name column then holds their blind index
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS encrypted_name BYTEA;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS attachments (
+    id SERIAL PRIMARY KEY,
+    resource_id INTEGER NOT NULL REFERENCES resources(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    encrypted_name BYTEA NOT NULL,     -- file name (encrypted)
+    storage VARCHAR(20) NOT NULL,      -- "postgres" | "minio"
+    object_key VARCHAR(500),           -- object key in MinIO (if storage = "minio")
+    size BIGINT DEFAULT 0,
+    metadata BYTEA,                    -- additional metadata (encrypted)
+    data BYTEA,                        -- data (if storage = "postgres")
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_resource_id ON attachments(resource_id);
@@ -0,0 +1,10 @@
+-- User-defined resource types, built-in types are not stored
+CREATE TABLE IF NOT EXISTS resource_types (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(32) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    fields JSONB NOT NULL,              -- field definitions
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (user_id, name)
+);
@@ -0,0 +1,11 @@
+-- Expiry and rotation are stored in plaintext so the server can list secrets that are due
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP DEFAULT NULL;
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS rotate_every BIGINT NOT NULL DEFAULT 0; -- seconds, 0 if the secret is not rotated
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS rotated_at TIMESTAMP; -- when the data last changed
+
+UPDATE resources SET rotated_at = COALESCE(updated_at, created_at) WHERE rotated_at IS NULL;
+ALTER TABLE resources ALTER COLUMN rotated_at SET DEFAULT NOW();
+ALTER TABLE resources ALTER COLUMN rotated_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_resources_due ON resources(user_id)
+    WHERE deleted_at IS NULL AND (expires_at IS NOT NULL OR rotate_every > 0);